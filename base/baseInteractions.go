@@ -8,30 +8,39 @@ import (
 	"fmt"
 	"log"
 	"math/big"
-	"strings"
+	"time"
 
 	"github.com/Thektonic/eth-interfaces/customerrors"
 	"github.com/Thektonic/eth-interfaces/hex"
-	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/signer"
 	"github.com/Thektonic/eth-interfaces/transaction"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient/simulated"
 )
 
-// Interactions holds the context, client, sender address, private key, disperse contract, and explorer URL.
+// Interactions holds the context, client, sender address, signer, disperse contract, and explorer URL.
 type Interactions struct {
-	Ctx      context.Context
-	Client   simulated.Client
-	Address  common.Address
-	pk       *ecdsa.PrivateKey
-	disperse *inferences.Disperse
-	explorer *string
-	TxOptsFn transaction.TxOptsMiddlewareFunc
-	safe     bool
+	Ctx                context.Context
+	Client             simulated.Client
+	Address            common.Address
+	signer             signer.Signer
+	disperse           *common.Address
+	explorer           *string
+	TxOptsFn           transaction.TxOptsMiddlewareFunc
+	safe               bool
+	feeMode            FeeMode
+	maxFeeMultiplier   uint64
+	nonces             *NonceManager
+	bumpPolicy         *BumpPolicy
+	pollInterval       time.Duration
+	confirmations      uint64
+	readOnly           bool
+	multicall          *common.Address
+	multicallChunkSize int
+	batching           *transaction.BatchingBackend
 }
 
 // Session holds call options and bound contract instance for contract interactions
@@ -61,6 +70,7 @@ type IBaseInteractions interface {
 }
 
 // NewBaseInteractions creates a new instance of BaseInteractions for blockchain interaction.
+// It exits the program on failure; use NewInteractions to handle the errors.
 func NewBaseInteractions(
 	client simulated.Client,
 	pk *ecdsa.PrivateKey,
@@ -68,65 +78,104 @@ func NewBaseInteractions(
 	safe bool,
 	txOptsFn ...transaction.TxOptsMiddlewareFunc,
 ) *Interactions {
-	ctx := context.TODO()
-	_, err := client.BlockNumber(ctx)
+	i, err := NewInteractions(client, legacyOptions(WithPrivateKey(pk), explorer, safe, txOptsFn)...)
 	if err != nil {
 		log.Fatal(err)
 	}
+	return i
+}
 
-	publicKey := pk.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("error casting public key to ECDSA")
+// NewBaseInteractionsFromSigner creates a new instance of BaseInteractions signing with the given signer.
+// It exits the program on failure; use NewInteractions to handle the errors.
+func NewBaseInteractionsFromSigner(
+	client simulated.Client,
+	s signer.Signer,
+	explorer *string,
+	safe bool,
+	txOptsFn ...transaction.TxOptsMiddlewareFunc,
+) *Interactions {
+	i, err := NewInteractions(client, legacyOptions(WithSigner(s), explorer, safe, txOptsFn)...)
+	if err != nil {
+		log.Fatal(err)
 	}
+	return i
+}
 
-	var txOptFn transaction.TxOptsMiddlewareFunc
-
+// legacyOptions maps the positional parameters of the legacy constructors to options.
+// As before, only the first middleware is used.
+func legacyOptions(
+	signerOpt Option,
+	explorer *string,
+	safe bool,
+	txOptsFn []transaction.TxOptsMiddlewareFunc,
+) []Option {
+	opts := []Option{signerOpt, WithSafe(safe)}
+	if explorer != nil {
+		opts = append(opts, WithExplorer(*explorer))
+	}
 	if len(txOptsFn) != 0 {
-		txOptFn = txOptsFn[0]
+		opts = append(opts, WithMiddlewares(txOptsFn[0]))
 	}
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	return &Interactions{ctx, client, fromAddress, pk, nil, explorer, txOptFn, safe}
+	return opts
 }
 
-// SetDisperse initializes the disperse contract for multi-address fund transfers.
-func (i *Interactions) SetDisperse(_ string) error {
-	var err error
-	i.disperse = inferences.NewDisperse()
-	return err
+// Signer returns the signer authorizing the interactions' transactions and messages.
+func (i *Interactions) Signer() signer.Signer {
+	return i.signer
 }
 
-// BaseTxSetup sets up transaction options (nonce, gas price, chain ID, etc.) for sending a transaction.
+// BaseTxSetup sets up transaction options (nonce, fees, chain ID, etc.) for sending a transaction.
+// Fees are priced according to the interactions' FeeMode.
 func (i *Interactions) BaseTxSetup() (*bind.TransactOpts, error) {
-	gasPrice, err := i.Client.SuggestGasPrice(i.Ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to suggest gas price: %v", err)
+	return i.BaseTxSetupCtx(i.Ctx)
+}
+
+// BaseTxSetupCtx sets up transaction options like BaseTxSetup, using ctx for the lookups and signing.
+func (i *Interactions) BaseTxSetupCtx(ctx context.Context) (*bind.TransactOpts, error) {
+	if i.readOnly {
+		return nil, ErrReadOnly
 	}
-	nonce, err := i.Client.PendingNonceAt(i.Ctx, i.Address)
+	fees, err := i.SuggestFees(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user nonce: %v", err)
+		return nil, err
 	}
-
-	chainID, err := i.Client.ChainID(i.Ctx)
+	chainID, err := i.Client.ChainID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get chain ID: %v", err)
 	}
 
-	opts, err := bind.NewKeyedTransactorWithChainID(i.pk, chainID)
+	opts := i.transactOpts(ctx, chainID)
+
+	nonce, err := i.nonces.Next(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	opts.From = i.Address
-	opts.GasPrice = gasPrice
-
-	opts.From = i.Address
+	fees.apply(opts)
 	opts.Nonce = new(big.Int).SetUint64(nonce)
 
 	if i.TxOptsFn != nil {
-		return i.TxOptsFn(opts)
-	} else {
-		return opts, nil
+		opts, err = i.TxOptsFn(opts)
+		if err != nil {
+			i.nonces.Release(nonce)
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// transactOpts returns transaction options signing through the interactions' signer.
+func (i *Interactions) transactOpts(ctx context.Context, chainID *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From: i.Address,
+		Signer: func(address common.Address, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+			if address != i.Address {
+				return nil, bind.ErrNotAuthorized
+			}
+			return i.signer.SignTx(ctx, tx, chainID)
+		},
+		Context: ctx,
 	}
 }
 
@@ -138,13 +187,14 @@ func (i *Interactions) BaseCallSetup() *bind.CallOpts {
 	}
 }
 
-// CatchTx waits for a transaction to be mined and returns its hash or an error message.
+// CatchTx waits for a transaction to be mined and confirmed and returns its hash or an error message.
+// Reverted transactions are reported as failures.
 func (i *Interactions) CatchTx(tx *ethTypes.Transaction, err error) (string, error) {
 	if err != nil {
 		return FailedTx(err)
 	}
-	receipt, err := bind.WaitMined(context.Background(), i.Client, tx)
-	if receipt == nil {
+	receipt, err := i.WaitReceipt(i.Ctx, tx, i.confirmations)
+	if err != nil {
 		return FailedTx(err)
 	}
 	if i.explorer != nil {
@@ -166,50 +216,9 @@ func (i *Interactions) VerifyTransaction(ctx context.Context, to common.Address,
 	return err
 }
 
-// Disperse uses the disperse contract to send funds to multiple addresses.
-func (i *Interactions) Disperse(addresses []common.Address, totalValue uint) (string, error) {
-	if i.disperse == nil {
-		return FailedTx(fmt.Errorf("disperse contract not initialized"))
-	}
-
-	amounts := []*big.Int{}
-	for range addresses {
-		amounts = append(amounts, new(big.Int).SetUint64(uint64(totalValue)/uint64(len(addresses))))
-	}
-
-	originalTxOptsFn := i.TxOptsFn
-	i.TxOptsFn = func(opts *bind.TransactOpts) (*bind.TransactOpts, error) {
-		opts.Value = new(big.Int).SetUint64(uint64(totalValue))
-		return opts, nil
-	}
-
-	defer func() { i.TxOptsFn = originalTxOptsFn }()
-
-	fmt.Println("Dispersing...")
-
-	instance := i.disperse.Instance(i.Client, i.Address)
-
-	tx, err := transaction.Transact(
-		i,
-		&Session{callOpts: i.BaseCallSetup(), instance: instance},
-		i.disperse.PackDisperseEther(addresses, amounts),
-		transaction.DefaultUnpacker,
-	)
-
-	if err != nil {
-		return FailedTx(err)
-	}
-
-	return i.CatchTx(tx, err)
-}
-
 // SendAllFunds transfers the entire balance to a designated address after fee estimation.
 func (i *Interactions) SendAllFunds(to common.Address) (*ethTypes.Transaction, error) {
-	bn, err := i.Client.BlockNumber(i.Ctx)
-	if err != nil {
-		return nil, err
-	}
-	balance, err := i.Client.BalanceAt(i.Ctx, i.Address, new(big.Int).SetUint64(bn))
+	balance, err := i.Client.BalanceAt(i.Ctx, i.Address, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -226,47 +235,47 @@ func (i *Interactions) SendAllFunds(to common.Address) (*ethTypes.Transaction, e
 		return nil, err
 	}
 
-	gasPrice, err := i.Client.SuggestGasPrice(context.Background())
+	fees, err := i.SuggestFees(i.Ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	gasCost := new(big.Int).SetUint64(gasLimit)
-	gasCost.Mul(gasCost, gasPrice)
-	value := big.NewInt(0).Add(balance, gasCost)
-	if value.Int64() < balance.Int64() {
-		return i.TransferETH(to, value)
+	gasCost.Mul(gasCost, fees.MaxPrice())
+	value := new(big.Int).Sub(balance, gasCost)
+	if value.Sign() > 0 {
+		return i.sendETH(to, value, gasLimit, fees)
 	}
 	return nil, fmt.Errorf(
 		"fees exceed balances\nfees : %f ETH\nbalance : %f ETH",
-		hex.ParseEther(big.NewInt(0).Sub(balance, value)),
+		hex.ParseEther(gasCost),
 		hex.ParseEther(balance),
 	)
 }
 
 // TransferETH transfers Ether to the specified address, ensuring sufficient balance and proper fee estimation.
 func (i *Interactions) TransferETH(to common.Address, value *big.Int) (*ethTypes.Transaction, error) {
-	balance, err := i.Client.BalanceAt(i.Ctx, to, nil)
+	balance, err := i.Client.BalanceAt(i.Ctx, i.Address, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	msg := ethereum.CallMsg{From: i.Address, To: &to, Value: balance, Data: nil}
+	msg := ethereum.CallMsg{From: i.Address, To: &to, Value: value, Data: nil}
 
 	gasLimit, err := i.Client.EstimateGas(i.Ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	gasPrice, err := i.Client.SuggestGasPrice(context.Background())
+	fees, err := i.SuggestFees(i.Ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	gasCost := new(big.Int).SetUint64(gasLimit)
-	gasCost.Mul(gasCost, gasPrice)
-	txCost := big.NewInt(0).Add(value, gasCost)
-	if txCost.Uint64() < balance.Uint64() {
+	gasCost.Mul(gasCost, fees.MaxPrice())
+	txCost := new(big.Int).Add(value, gasCost)
+	if txCost.Cmp(balance) > 0 {
 		return nil, fmt.Errorf(
 			"unsufficient balance for the transfer\n value + fees : %f ETH\nbalance : %f ETH",
 			hex.ParseEther(txCost),
@@ -274,98 +283,64 @@ func (i *Interactions) TransferETH(to common.Address, value *big.Int) (*ethTypes
 		)
 	}
 
-	nonce, err := i.Client.PendingNonceAt(context.Background(), i.Address)
-	if err != nil {
-		return nil, err
+	return i.sendETH(to, value, gasLimit, fees)
+}
+
+// sendETH signs and broadcasts a plain Ether transfer priced with the given fees.
+func (i *Interactions) sendETH(
+	to common.Address,
+	value *big.Int,
+	gasLimit uint64,
+	fees *Fees,
+) (*ethTypes.Transaction, error) {
+	if i.readOnly {
+		return nil, ErrReadOnly
 	}
 
-	// Create the transaction
-	tx := ethTypes.NewTx(&ethTypes.LegacyTx{
-		Nonce:    nonce,
-		To:       &to,
-		Value:    value,
-		Gas:      gasLimit,
-		GasPrice: gasPrice,
-	})
 	// Get the chain ID
 	chainID, err := i.Client.ChainID(i.Ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	nonce, err := i.nonces.Next(i.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the transaction
+	tx := newTx(chainID, nonce, &to, value, gasLimit, nil, fees)
+
 	// Sign the transaction
-	signedTx, err := ethTypes.SignTx(tx, ethTypes.NewEIP155Signer(chainID), i.pk)
+	signedTx, err := i.signer.SignTx(i.Ctx, tx, chainID)
 	if err != nil {
+		i.ReleaseNonce(nonce, err)
 		return nil, fmt.Errorf("failed to sign the tx: %w", err)
 	}
 
 	// Broadcast the transaction
-	err = i.Client.SendTransaction(context.Background(), signedTx)
+	err = i.Client.SendTransaction(i.Ctx, signedTx)
 	if err != nil {
+		i.ReleaseNonce(nonce, err)
 		return nil, fmt.Errorf("failed to send the tx: %w", err)
 	}
 
 	return signedTx, nil
 }
 
-// CheckSignatures checks if a contract supports specific function signatures.
+// CheckSignatures checks if a contract supports specific function signatures, see DetectCapabilities.
 func (i *Interactions) CheckSignatures(contractAddress common.Address, signatures []hex.Signature) error {
-	// Get proxy bytecode
-	byteCode, err := i.Client.CodeAt(i.Ctx, contractAddress, nil)
+	capabilities, err := i.DetectCapabilities(i.Ctx, contractAddress, signatures)
 	if err != nil {
-		return fmt.Errorf("failed to get contract bytecode: %w", err)
-	}
-	byteCodeHex := common.Bytes2Hex(byteCode)
-
-	// Check signatures against proxy bytecode first
-	var notFoundSigs []hex.Signature
-	for _, signature := range signatures {
-		sigHex := signature.GetHex()
-		if !strings.Contains(byteCodeHex, sigHex[:8]) {
-			notFoundSigs = append(notFoundSigs, signature)
-		}
-	}
-
-	// If all signatures found, return success
-	if len(notFoundSigs) == 0 {
-		return nil
-	}
-
-	// Check implementation contract if any signatures not found
-	implAddress, err := hex.GetImplementationAddress(i.Ctx, i.Client, contractAddress)
-	if err != nil {
-		return customerrors.WrapInterfacingError("CheckSignatures", err)
+		return err
 	}
 
 	notSupported := ""
-
-	// If implementation found, check remaining sigs against it
-	if implAddress != (common.Address{}) {
-		implCode, err := i.Client.CodeAt(i.Ctx, implAddress, nil)
-		if err != nil {
-			// If can't get implementation code, mark all remaining as not supported
-			for _, sig := range notFoundSigs {
-				notSupported += fmt.Sprintf("%s: %s\n", sig, sig.GetHex()[:8])
-			}
-		} else {
-			implCodeHex := common.Bytes2Hex(implCode)
-			for _, sig := range notFoundSigs {
-				sigHex := sig.GetHex()
-				if !strings.Contains(implCodeHex, sigHex[:8]) {
-					notSupported += fmt.Sprintf("%s: %s\n", sig, sigHex[:8])
-				}
-			}
-		}
-	} else {
-		// Check diamond facets for remaining signatures
-		for _, sig := range notFoundSigs {
-			supported, err := hex.CheckDiamondFunction(i.Ctx, i.Client, contractAddress, sig.GetSelector())
-			if err != nil || !supported {
-				notSupported += fmt.Sprintf("%s: %s\n", sig, sig.GetHex()[:8])
-			}
+	for _, sig := range signatures {
+		if !capabilities.Supports(sig.String()) {
+			notSupported += fmt.Sprintf("%s: %s\n", sig, sig.GetHex()[:8])
 		}
 	}
-
 	if len(notSupported) > 0 {
 		return customerrors.WrapInterfacingError("CheckSignatures", fmt.Errorf("not supported functions: %s", notSupported))
 	}
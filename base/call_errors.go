@@ -3,6 +3,7 @@ package base
 import (
 	"fmt"
 
+	"github.com/Thektonic/eth-interfaces/hex"
 	"github.com/ethereum/go-ethereum/ethclient"
 )
 
@@ -44,7 +45,8 @@ func GenCallError(
 			return nil
 		}
 		errBytes, success := ethclient.RevertErrorData(err)
-		if success {
+		// Reverts without data, such as bare require statements, carry no error to unpack.
+		if success && len(errBytes) >= hex.ErrorMethodIDLength {
 			data, unpackErr := unpackError(errBytes)
 			if unpackErr != nil {
 				return fmt.Errorf("failed to unpack error data: %w", unpackErr)
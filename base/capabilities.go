@@ -0,0 +1,230 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Thektonic/eth-interfaces/customerrors"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// DetectionMethod is how the support of a function by a contract was established.
+type DetectionMethod string
+
+const (
+	// NotDetected means the function was not found by any method.
+	NotDetected DetectionMethod = ""
+	// DetectedERC165 means the contract reports supporting an interface containing the function.
+	DetectedERC165 DetectionMethod = "erc165"
+	// DetectedBytecode means the function dispatcher of the contract's code compares the selector.
+	DetectedBytecode DetectionMethod = "bytecode"
+	// DetectedImplementation means the function dispatcher of a contract the contract delegates to,
+	// possibly through several proxies, compares the selector.
+	DetectedImplementation DetectionMethod = "implementation"
+	// DetectedDiamond means an EIP-2535 diamond routes the selector to a facet.
+	DetectedDiamond DetectionMethod = "diamond"
+)
+
+// Capabilities are the interfaces and functions detected on a contract.
+type Capabilities struct {
+	// ERC165 reports whether the contract implements ERC165 interface detection.
+	ERC165 bool
+	// Interfaces holds the support of the hex.KnownInterfaces, queried only when ERC165 is implemented.
+	Interfaces map[[4]byte]bool
+	// Methods holds how each requested function was detected, keyed by signature.
+	Methods map[string]DetectionMethod
+	// Chain holds the proxy layers resolved from the contract, only when its own code lacks functions.
+	Chain []hex.ProxyLayer
+}
+
+// Supports reports whether the function with the given signature was detected.
+func (c *Capabilities) Supports(signature string) bool {
+	return c.Methods[signature] != NotDetected
+}
+
+// Unsupported returns the signatures of the requested functions that were not detected.
+func (c *Capabilities) Unsupported() []string {
+	var unsupported []string
+	for signature, method := range c.Methods {
+		if method == NotDetected {
+			unsupported = append(unsupported, signature)
+		}
+	}
+	slices.Sort(unsupported)
+	return unsupported
+}
+
+// SupportsInterface queries the contract's ERC165 supportsInterface function. Calls that revert or
+// return malformed data report the interface as unsupported, while failing calls return the error.
+func (i *Interactions) SupportsInterface(
+	ctx context.Context,
+	contractAddress common.Address,
+	interfaceID [4]byte,
+) (bool, error) {
+	selector := hex.SelectorOf("supportsInterface(bytes4)")
+	data := append(selector[:], common.RightPadBytes(interfaceID[:], 32)...)
+	result, err := i.Backend().CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: data}, nil)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		if _, reverted := ethclient.RevertErrorData(err); reverted {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to call supportsInterface: %w", err)
+	}
+	if len(result) != 32 {
+		return false, nil
+	}
+	return common.BytesToHash(result) == common.BigToHash(common.Big1), nil
+}
+
+// DetectCapabilities establishes which of the functions the contract supports. The interfaces the
+// contract reports through ERC165 are trusted first; the remaining selectors are looked for among the
+// selectors extracted from the contract's code, then from the code of every layer of its proxy chain,
+// and among the facets when the chain ends with an EIP-2535 diamond.
+func (i *Interactions) DetectCapabilities(
+	ctx context.Context,
+	contractAddress common.Address,
+	signatures []hex.Signature,
+) (*Capabilities, error) {
+	capabilities := &Capabilities{
+		Interfaces: make(map[[4]byte]bool),
+		Methods:    make(map[string]DetectionMethod, len(signatures)),
+	}
+	if err := i.detectInterfaces(ctx, contractAddress, capabilities); err != nil {
+		return nil, err
+	}
+
+	var remaining []hex.Signature
+	for _, signature := range signatures {
+		if capabilities.coveredByInterface(signature.String()) {
+			capabilities.Methods[signature.String()] = DetectedERC165
+			continue
+		}
+		capabilities.Methods[signature.String()] = NotDetected
+		remaining = append(remaining, signature)
+	}
+	if len(remaining) == 0 {
+		return capabilities, nil
+	}
+
+	byteCode, err := i.Client.CodeAt(ctx, contractAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contract bytecode: %w", err)
+	}
+	remaining = capabilities.matchCode(byteCode, remaining, DetectedBytecode)
+	if len(remaining) == 0 {
+		return capabilities, nil
+	}
+
+	capabilities.Chain, err = hex.ResolveProxy(ctx, i.Client, contractAddress)
+	if err != nil {
+		return nil, customerrors.WrapInterfacingError("CheckSignatures", err)
+	}
+	for _, layer := range capabilities.Chain[1:] {
+		// Layers whose code cannot be fetched leave their functions undetected.
+		if code, err := i.Client.CodeAt(ctx, layer.Address, nil); err == nil {
+			remaining = capabilities.matchCode(code, remaining, DetectedImplementation)
+		}
+	}
+
+	last := capabilities.Chain[len(capabilities.Chain)-1]
+	if last.Kind == hex.DiamondProxy {
+		capabilities.matchFacets(last.Facets, remaining)
+		return capabilities, nil
+	}
+	// Diamonds without the facets function may still answer facetAddress.
+	for _, signature := range remaining {
+		supported, err := hex.CheckDiamondFunction(ctx, i.Client, last.Address, signature.GetSelector())
+		if err == nil && supported {
+			capabilities.Methods[signature.String()] = DetectedDiamond
+		}
+	}
+	return capabilities, nil
+}
+
+// detectInterfaces records whether the contract implements ERC165 and, if so, which known interfaces
+// it supports. The queries share a JSON-RPC batch when RPC batching is enabled.
+func (i *Interactions) detectInterfaces(
+	ctx context.Context,
+	contractAddress common.Address,
+	capabilities *Capabilities,
+) error {
+	// EIP-165 requires supporting its own interface and rejecting the invalid one.
+	var supportsERC165, supportsInvalid bool
+	var erc165Err, invalidErr error
+	i.RunReads(
+		func() {
+			supportsERC165, erc165Err = i.SupportsInterface(ctx, contractAddress, hex.IERC165InterfaceID)
+		},
+		func() {
+			supportsInvalid, invalidErr = i.SupportsInterface(ctx, contractAddress, hex.InvalidInterfaceID)
+		},
+	)
+	if erc165Err != nil {
+		return erc165Err
+	}
+	if invalidErr != nil {
+		return invalidErr
+	}
+	capabilities.ERC165 = supportsERC165 && !supportsInvalid
+	if !capabilities.ERC165 {
+		return nil
+	}
+
+	supported := make([]bool, len(hex.KnownInterfaces))
+	errs := make([]error, len(hex.KnownInterfaces))
+	reads := make([]func(), len(hex.KnownInterfaces))
+	for idx, known := range hex.KnownInterfaces {
+		reads[idx] = func() { supported[idx], errs[idx] = i.SupportsInterface(ctx, contractAddress, known.ID) }
+	}
+	i.RunReads(reads...)
+	for idx, known := range hex.KnownInterfaces {
+		if errs[idx] != nil {
+			return errs[idx]
+		}
+		capabilities.Interfaces[known.ID] = supported[idx]
+	}
+	return nil
+}
+
+// coveredByInterface reports whether a supported known interface contains the function.
+func (c *Capabilities) coveredByInterface(signature string) bool {
+	for _, known := range hex.KnownInterfaces {
+		if c.Interfaces[known.ID] && slices.Contains(known.Functions, signature) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchCode marks the functions whose selector the code dispatches with method and returns the others.
+func (c *Capabilities) matchCode(code []byte, signatures []hex.Signature, method DetectionMethod) []hex.Signature {
+	selectors := hex.ExtractSelectors(code)
+	var remaining []hex.Signature
+	for _, signature := range signatures {
+		if slices.Contains(selectors, hex.Selector(signature.GetSelector())) {
+			c.Methods[signature.String()] = method
+			continue
+		}
+		remaining = append(remaining, signature)
+	}
+	return remaining
+}
+
+// matchFacets marks the functions whose selector one of the diamond's facets handles.
+func (c *Capabilities) matchFacets(facets []hex.Facet, signatures []hex.Signature) {
+	for _, signature := range signatures {
+		for _, facet := range facets {
+			if slices.Contains(facet.Selectors, hex.Selector(signature.GetSelector())) {
+				c.Methods[signature.String()] = DetectedDiamond
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,297 @@
+package base_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	ethhex "github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+// signature is a function signature given as plain text.
+type signature string
+
+func (s signature) GetHex() string {
+	selector := ethhex.SelectorOf(string(s))
+	return hex.EncodeToString(selector[:])
+}
+
+func (s signature) String() string {
+	return string(s)
+}
+
+func (s signature) GetSelector() []byte {
+	selector := ethhex.SelectorOf(string(s))
+	return selector[:]
+}
+
+// unreachableClient fails its calls as a node that cannot be reached.
+type unreachableClient struct {
+	simulated.Client
+}
+
+func (c *unreachableClient) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return nil, errUnreachable
+}
+
+// Test_InterfaceIDs verifies that the known interfaces list the functions their IDs are made of.
+func Test_InterfaceIDs(t *testing.T) {
+	for _, known := range ethhex.KnownInterfaces {
+		assert.Equal(t, known.ID, ethhex.InterfaceID(known.Functions...), known.Name)
+	}
+	assert.Equal(t, ethhex.IERC165InterfaceID, ethhex.InterfaceID("supportsInterface(bytes4)"))
+}
+
+// Test_DetectCapabilities verifies the method each function is detected with.
+func Test_DetectCapabilities(t *testing.T) {
+	backend, auth, nftAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	erc20Addr, _, _, err := ethhex.DeployContract(auth, backend.Client(),
+		inferences.Ierc20MetaData.ABI, inferences.Ierc20MetaData.Bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	erc1155Addr, _, _, err := ethhex.DeployContract(auth, backend.Client(),
+		inferences.Ierc1155MetaData.ABI, inferences.Ierc1155MetaData.Bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The runtime code only holds the ownerOf selector inside the operand of a PUSH32, where
+	// scanning the hex code for substrings finds it.
+	unaligned, _, _, err := ethhex.DeployContract(auth, backend.Client(), "[]",
+		"6022600c60003960226000f3"+"7f"+signature("ownerOf(uint256)").GetHex()[:8]+
+			"00000000000000000000000000000000000000000000000000000000"+"00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	// An EIP-1967 proxy delegating to a clone of the ERC721 contract.
+	clone, err := testingtools.DeployRuntime(auth, backend, common.FromHex("0x363d3d373d3d3d363d73"+
+		nftAddr.Hex()[2:]+"5af43d82803e903d91602b57fd5bf3"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy, err := testingtools.DeployRuntime(auth, backend, common.FromHex("0x365f5f375f5f365f7f"+
+		ethhex.EIP1967ImplementationSlot.Hex()[2:]+"545af43d5f5f3e6036573d5ffd5b3d5ff3"),
+		map[common.Hash]common.Address{ethhex.EIP1967ImplementationSlot: *clone})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A diamond whose loupe lists a single facet.
+	facets, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "facetAddress", Type: "address"},
+		{Name: "functionSelectors", Type: "bytes4[]"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	facetsData, err := abi.Arguments{{Type: facets}}.Pack([]struct {
+		FacetAddress      common.Address
+		FunctionSelectors [][4]byte
+	}{{FacetAddress: *nftAddr, FunctionSelectors: [][4]byte{ethhex.SelectorOf("totalSupply()")}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diamond, err := testingtools.DeployRuntime(auth, backend, testingtools.ReturningCode(0, facetsData), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name       string
+		Contract   common.Address
+		ERC165     bool
+		Interfaces map[[4]byte]bool
+		Methods    map[string]base.DetectionMethod
+		Chain      []common.Address
+	}{
+		{
+			Name:     "OK - ERC721 reporting its interfaces",
+			Contract: *nftAddr,
+			ERC165:   true,
+			Interfaces: map[[4]byte]bool{
+				ethhex.IERC721InterfaceID:           true,
+				ethhex.IERC721MetadataInterfaceID:   true,
+				ethhex.IERC721EnumerableInterfaceID: false,
+				ethhex.IERC1155InterfaceID:          false,
+			},
+			Methods: map[string]base.DetectionMethod{
+				"ownerOf(uint256)":      base.DetectedERC165,
+				"tokenURI(uint256)":     base.DetectedERC165,
+				"totalSupply()":         base.DetectedBytecode,
+				"uri(uint256)":          base.NotDetected,
+				"mint(address,uint256)": base.NotDetected,
+			},
+		},
+		{
+			Name:     "OK - ERC20 without ERC165",
+			Contract: erc20Addr,
+			Methods: map[string]base.DetectionMethod{
+				"balanceOf(address)": base.DetectedBytecode,
+				"ownerOf(uint256)":   base.NotDetected,
+			},
+		},
+		{
+			Name:     "OK - ERC1155 with metadata URI",
+			Contract: erc1155Addr,
+			ERC165:   true,
+			Interfaces: map[[4]byte]bool{
+				ethhex.IERC1155InterfaceID:            true,
+				ethhex.IERC1155MetadataURIInterfaceID: true,
+				ethhex.IERC721InterfaceID:             false,
+			},
+			Methods: map[string]base.DetectionMethod{
+				"balanceOf(address,uint256)": base.DetectedERC165,
+				"uri(uint256)":               base.DetectedERC165,
+				"balanceOf(address)":         base.NotDetected,
+			},
+		},
+		{
+			Name:     "OK - Proxy chain",
+			Contract: *proxy,
+			ERC165:   true,
+			Interfaces: map[[4]byte]bool{
+				ethhex.IERC721InterfaceID: true,
+			},
+			Methods: map[string]base.DetectionMethod{
+				"ownerOf(uint256)": base.DetectedERC165,
+				"totalSupply()":    base.DetectedImplementation,
+				"uri(uint256)":     base.NotDetected,
+			},
+			Chain: []common.Address{*proxy, *clone, *nftAddr},
+		},
+		{
+			Name:     "OK - Diamond facets",
+			Contract: *diamond,
+			Methods: map[string]base.DetectionMethod{
+				"totalSupply()":    base.DetectedDiamond,
+				"ownerOf(uint256)": base.NotDetected,
+			},
+			Chain: []common.Address{*diamond},
+		},
+		{
+			Name:     "OK - Selector inside push data",
+			Contract: unaligned,
+			Methods: map[string]base.DetectionMethod{
+				"ownerOf(uint256)": base.NotDetected,
+			},
+		},
+	}
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			var signatures []ethhex.Signature
+			for sig := range tt.Methods {
+				signatures = append(signatures, signature(sig))
+			}
+			capabilities, err := baseInteractions.DetectCapabilities(context.Background(), tt.Contract, signatures)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.ERC165, capabilities.ERC165)
+			for id, supported := range tt.Interfaces {
+				assert.Equal(t, supported, capabilities.Interfaces[id], "interface %x", id)
+			}
+			assert.Equal(t, tt.Methods, capabilities.Methods)
+			if tt.Chain != nil {
+				chain := make([]common.Address, len(capabilities.Chain))
+				for idx, layer := range capabilities.Chain {
+					chain[idx] = layer.Address
+				}
+				assert.Equal(t, tt.Chain, chain)
+			}
+		})
+	}
+
+	err = baseInteractions.CheckSignatures(*nftAddr, []ethhex.Signature{signature("ownerOf(uint256)")})
+	assert.Nil(t, err)
+	err = baseInteractions.CheckSignatures(unaligned, []ethhex.Signature{signature("ownerOf(uint256)")})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "not supported functions: ownerOf(uint256)")
+	}
+}
+
+// Test_SupportsInterface verifies that reverting calls report the interface as unsupported while
+// failing calls return the error.
+func Test_SupportsInterface(t *testing.T) {
+	backend, auth, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+	reverting, err := testingtools.DeployRuntime(auth, backend, []byte{0x5f, 0x5f, 0xfd}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name          string
+		Client        simulated.Client
+		ExpectError   bool
+		ExpectedError error
+	}{
+		{
+			Name:   "OK - Reverting call",
+			Client: backend.Client(),
+		},
+		{
+			Name:          "KO - Unreachable node",
+			Client:        &unreachableClient{backend.Client()},
+			ExpectError:   true,
+			ExpectedError: errUnreachable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			baseInteractions := base.NewBaseInteractions(tt.Client, privKey, nil, false)
+			supported, err := baseInteractions.SupportsInterface(
+				context.Background(), *reverting, ethhex.IERC165InterfaceID,
+			)
+			if tt.ExpectError {
+				assert.ErrorIs(t, err, tt.ExpectedError)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.False(t, supported)
+
+			_, err = baseInteractions.DetectCapabilities(context.Background(), *reverting, nil)
+			if tt.ExpectError {
+				assert.ErrorIs(t, err, tt.ExpectedError)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package base
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrDisperseNotSet is returned when no Disperse contract is set nor registered for the chain.
+	ErrDisperseNotSet = errors.New("disperse contract not initialized")
+	// ErrNothingToDisperse is returned when no recipient has a positive amount.
+	ErrNothingToDisperse = errors.New("nothing to disperse")
+	// ErrBatchTooLarge is returned when a single recipient does not fit in the batch gas limit.
+	ErrBatchTooLarge = errors.New("recipient does not fit in the batch gas limit")
+	// ErrInsufficientFunds is returned when the balance does not cover the dispersed amounts and fees.
+	ErrInsufficientFunds = errors.New("insufficient funds to disperse")
+)
+
+// outOfGasErrors lists the gas estimation error messages of calls needing more gas than allowed.
+var outOfGasErrors = []string{
+	"gas required exceeds allowance",
+	"out of gas",
+}
+
+// isOutOfGasError reports whether the gas estimation failed because the call needs more gas than allowed.
+func isOutOfGasError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, gasErr := range outOfGasErrors {
+		if strings.Contains(msg, gasErr) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	disperseRegistryMu sync.RWMutex
+	disperseRegistry   = map[uint64]common.Address{}
+)
+
+// RegisterDisperse registers the address of the Disperse contract deployed on the given chain.
+func RegisterDisperse(chainID uint64, address common.Address) {
+	disperseRegistryMu.Lock()
+	defer disperseRegistryMu.Unlock()
+	disperseRegistry[chainID] = address
+}
+
+// RegisteredDisperse returns the address of the Disperse contract registered for the given chain.
+func RegisteredDisperse(chainID uint64) (common.Address, bool) {
+	disperseRegistryMu.RLock()
+	defer disperseRegistryMu.RUnlock()
+	address, ok := disperseRegistry[chainID]
+	return address, ok
+}
+
+// SetDisperse sets the Disperse contract used for multi-address fund transfers. An empty address
+// falls back to the contract registered for the client's chain.
+func (i *Interactions) SetDisperse(address string) error {
+	if address == "" {
+		i.disperse = nil
+		return nil
+	}
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("invalid disperse address %q", address)
+	}
+	disperse := common.HexToAddress(address)
+	i.disperse = &disperse
+	return nil
+}
+
+// DisperseAddress returns the Disperse contract set on the interactions, or the one registered
+// for the client's chain.
+func (i *Interactions) DisperseAddress(ctx context.Context) (common.Address, error) {
+	if i.disperse != nil {
+		return *i.disperse, nil
+	}
+	chainID, err := i.Client.ChainID(ctx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get chain ID: %v", err)
+	}
+	if address, ok := RegisteredDisperse(chainID.Uint64()); ok {
+		return address, nil
+	}
+	return common.Address{}, ErrDisperseNotSet
+}
+
+// DeployDisperse deploys the bundled Disperse contract, registers it for the client's chain and
+// sets it on the interactions.
+func (i *Interactions) DeployDisperse(ctx context.Context) (common.Address, error) {
+	opts, err := i.BaseTxSetupCtx(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	address, tx, _, err := hex.DeployContract(
+		opts,
+		i.Client,
+		inferences.DisperseMetaData.ABI,
+		inferences.DisperseMetaData.Bin,
+	)
+	if err != nil {
+		i.ReleaseNonce(opts.Nonce.Uint64(), err)
+		return common.Address{}, err
+	}
+	if _, err := i.WaitReceipt(ctx, tx, i.confirmations); err != nil {
+		return common.Address{}, err
+	}
+
+	chainID, err := i.Client.ChainID(ctx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get chain ID: %v", err)
+	}
+	RegisterDisperse(chainID.Uint64(), address)
+	i.disperse = &address
+	return address, nil
+}
+
+// SplitEvenly splits total between the recipients. The remainder of the division is handed out one wei
+// at a time to the first recipients, so that the amounts add up to total.
+func SplitEvenly(total *big.Int, recipients []common.Address) map[common.Address]*big.Int {
+	amounts := make(map[common.Address]*big.Int, len(recipients))
+	if len(recipients) == 0 {
+		return amounts
+	}
+	share, dust := new(big.Int).DivMod(total, big.NewInt(int64(len(recipients))), new(big.Int))
+	for idx, recipient := range recipients {
+		amount := new(big.Int).Set(share)
+		if dust.Cmp(big.NewInt(int64(idx))) > 0 {
+			amount.Add(amount, common.Big1)
+		}
+		if current, ok := amounts[recipient]; ok {
+			amount.Add(amount, current)
+		}
+		amounts[recipient] = amount
+	}
+	return amounts
+}
+
+// ParseDisperseCSV reads "address,amount" records. Amounts are decimal numbers scaled by 10^decimals,
+// so that "1.5" with 18 decimals reads as 1.5 ether; amounts more precise than decimals are rejected.
+// Empty lines and a leading header, a first record with neither an address nor an amount, are skipped,
+// and the amounts of repeated addresses are summed.
+func ParseDisperseCSV(r io.Reader, decimals uint8) (map[common.Address]*big.Int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	amounts := map[common.Address]*big.Int{}
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return amounts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		address := strings.TrimSpace(record[0])
+		amount, amountErr := parseAmount(strings.TrimSpace(record[1]), decimals)
+		if !common.IsHexAddress(address) {
+			if first && amountErr != nil {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid address %q", line, address)
+		}
+		if amountErr != nil {
+			return nil, fmt.Errorf("line %d: %w", line, amountErr)
+		}
+		recipient := common.HexToAddress(address)
+		if current, ok := amounts[recipient]; ok {
+			amount.Add(amount, current)
+		}
+		amounts[recipient] = amount
+	}
+}
+
+// parseAmount parses a non-negative decimal number scaled by 10^decimals.
+func parseAmount(value string, decimals uint8) (*big.Int, error) {
+	if value == "" {
+		return nil, errors.New("missing amount")
+	}
+	whole, fraction, _ := strings.Cut(value, ".")
+	if len(fraction) > int(decimals) {
+		return nil, fmt.Errorf("amount %q has more than %d decimals", value, decimals)
+	}
+	digits := whole + fraction + strings.Repeat("0", int(decimals)-len(fraction))
+	amount, ok := new(big.Int).SetString(digits, hex.DecimalBase)
+	if !ok || amount.Sign() < 0 || strings.ContainsAny(digits, "+-") {
+		return nil, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
+
+// Disperse uses the disperse contract to split totalValue evenly between multiple addresses.
+func (i *Interactions) Disperse(addresses []common.Address, totalValue uint) (string, error) {
+	receipts, err := i.DisperseETHCtx(i.Ctx, SplitEvenly(new(big.Int).SetUint64(uint64(totalValue)), addresses), 0)
+	if err != nil {
+		return FailedTx(err)
+	}
+	hash := receipts[len(receipts)-1].TxHash.Hex()
+	if i.explorer != nil {
+		return SuccessTx(fmt.Sprintf(*i.explorer, "/tx/", hash))
+	}
+	return SuccessTx(hash)
+}
+
+// DisperseETH sends each recipient its amount through the Disperse contract. See DisperseETHCtx.
+func (i *Interactions) DisperseETH(
+	amounts map[common.Address]*big.Int,
+	maxBatchGas uint64,
+) ([]*ethTypes.Receipt, error) {
+	return i.DisperseETHCtx(i.Ctx, amounts, maxBatchGas)
+}
+
+// DisperseETHCtx sends each recipient its amount through the Disperse contract using ctx. Recipients
+// with a zero amount are skipped. The recipients, in address order, are split into batches whose
+// estimated gas does not exceed maxBatchGas, the latest block gas limit when 0, and one transaction
+// is sent and waited for per batch. The balance is checked against the amounts and the estimated fees
+// before sending anything, so that ErrInsufficientFunds does not leave a partial payout. On failure,
+// the receipts of the batches already mined are returned along with the error.
+func (i *Interactions) DisperseETHCtx(
+	ctx context.Context,
+	amounts map[common.Address]*big.Int,
+	maxBatchGas uint64,
+) ([]*ethTypes.Receipt, error) {
+	recipients, values := sortedAmounts(amounts)
+	if len(recipients) == 0 {
+		return nil, ErrNothingToDisperse
+	}
+	disperse, err := i.DisperseAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if maxBatchGas == 0 {
+		header, err := i.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		maxBatchGas = header.GasLimit
+	}
+
+	balance, err := i.Client.PendingBalanceAt(ctx, i.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+	total := sum(values)
+	if balance.Cmp(total) < 0 {
+		return nil, fmt.Errorf("%w: balance %s, required %s", ErrInsufficientFunds, balance, total)
+	}
+
+	contract := inferences.NewDisperse()
+	estimate := func(start, end int) (uint64, error) {
+		return i.Client.EstimateGas(ctx, ethereum.CallMsg{
+			From:  i.Address,
+			To:    &disperse,
+			Value: sum(values[start:end]),
+			Data:  contract.PackDisperseEther(recipients[start:end], values[start:end]),
+		})
+	}
+	batches, err := splitBatches(0, len(recipients), maxBatchGas, estimate)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := i.SuggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	required := new(big.Int).Set(total)
+	for _, batch := range batches {
+		required.Add(required, new(big.Int).Mul(new(big.Int).SetUint64(batch.gas), fees.MaxPrice()))
+	}
+	if balance.Cmp(required) < 0 {
+		return nil, fmt.Errorf("%w: balance %s, required %s with fees", ErrInsufficientFunds, balance, required)
+	}
+
+	instance := contract.Instance(i.Client, disperse)
+	receipts := make([]*ethTypes.Receipt, 0, len(batches))
+	for _, batch := range batches {
+		opts, err := i.BaseTxSetupCtx(ctx)
+		if err != nil {
+			return receipts, err
+		}
+		opts.Value = sum(values[batch.start:batch.end])
+		calldata := contract.PackDisperseEther(recipients[batch.start:batch.end], values[batch.start:batch.end])
+		tx, err := instance.RawTransact(opts, calldata)
+		if err != nil {
+			i.ReleaseNonce(opts.Nonce.Uint64(), err)
+			return receipts, err
+		}
+		receipt, err := i.WaitReceipt(ctx, tx, i.confirmations)
+		if err != nil {
+			return receipts, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// batch is a range of recipients sent in a single transaction, along with its estimated gas.
+type batch struct {
+	start, end int
+	gas        uint64
+}
+
+// splitBatches splits the recipients from start to end into consecutive batches of estimated gas
+// lower than maxGas, halving the ranges that do not fit or run out of gas. Other estimation errors,
+// such as a recipient reverting, are returned as is.
+func splitBatches(
+	start, end int,
+	maxGas uint64,
+	estimate func(start, end int) (uint64, error),
+) ([]batch, error) {
+	gas, err := estimate(start, end)
+	switch {
+	case err == nil && gas <= maxGas:
+		return []batch{{start: start, end: end, gas: gas}}, nil
+	case err != nil && !isOutOfGasError(err):
+		return nil, err
+	case end-start == 1:
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrBatchTooLarge, err)
+		}
+		return nil, fmt.Errorf("%w: %d gas for %d", ErrBatchTooLarge, gas, maxGas)
+	}
+
+	middle := start + (end-start)/2
+	first, err := splitBatches(start, middle, maxGas, estimate)
+	if err != nil {
+		return nil, err
+	}
+	second, err := splitBatches(middle, end, maxGas, estimate)
+	if err != nil {
+		return nil, err
+	}
+	return append(first, second...), nil
+}
+
+// sortedAmounts returns the recipients with a positive amount in address order, along with their amounts.
+func sortedAmounts(amounts map[common.Address]*big.Int) ([]common.Address, []*big.Int) {
+	recipients := make([]common.Address, 0, len(amounts))
+	for recipient, amount := range amounts {
+		if amount != nil && amount.Sign() > 0 {
+			recipients = append(recipients, recipient)
+		}
+	}
+	sort.Slice(recipients, func(a, b int) bool {
+		return recipients[a].Cmp(recipients[b]) < 0
+	})
+
+	values := make([]*big.Int, len(recipients))
+	for idx, recipient := range recipients {
+		values[idx] = amounts[recipient]
+	}
+	return recipients, values
+}
+
+// sum returns the sum of the values.
+func sum(values []*big.Int) *big.Int {
+	total := new(big.Int)
+	for _, value := range values {
+		total.Add(total, value)
+	}
+	return total
+}
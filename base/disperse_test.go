@@ -0,0 +1,295 @@
+package base_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_ParseDisperseCSV verifies the parsing of recipients and decimal amounts.
+func Test_ParseDisperseCSV(t *testing.T) {
+	first := common.HexToAddress("0x1001")
+	second := common.HexToAddress("0x1002")
+
+	testCases := []struct {
+		Name           string
+		Input          string
+		Decimals       uint8
+		ExpectedResult map[common.Address]*big.Int
+		ExpectError    bool
+		ExpectedError  string
+	}{
+		{
+			Name:     "OK - Header and decimals",
+			Input:    "address,amount\n" + first.Hex() + ", 1.5\n\n" + second.Hex() + ",2\n",
+			Decimals: 18,
+			ExpectedResult: map[common.Address]*big.Int{
+				first:  big.NewInt(1_500_000_000_000_000_000),
+				second: big.NewInt(2_000_000_000_000_000_000),
+			},
+		},
+		{
+			Name:     "OK - Repeated address summed",
+			Input:    first.Hex() + ",10\n" + first.Hex() + ",5\n",
+			Decimals: 0,
+			ExpectedResult: map[common.Address]*big.Int{
+				first: big.NewInt(15),
+			},
+		},
+		{
+			Name:        "KO - Too many decimals",
+			Input:       first.Hex() + ",0.001\n",
+			Decimals:    2,
+			ExpectError: true,
+		},
+		{
+			Name:        "KO - Negative amount",
+			Input:       first.Hex() + ",-1\n",
+			ExpectError: true,
+		},
+		{
+			Name:        "KO - Invalid address",
+			Input:       first.Hex() + ",1\n0x12,1\n",
+			ExpectError: true,
+		},
+		{
+			Name:          "KO - Invalid first address",
+			Input:         "0x100g,1\n" + second.Hex() + ",2\n",
+			ExpectError:   true,
+			ExpectedError: `line 1: invalid address "0x100g"`,
+		},
+		{
+			Name:          "KO - Line number after empty lines",
+			Input:         "address,amount\n\n\n" + first.Hex() + ",1\n0x12,1\n",
+			ExpectError:   true,
+			ExpectedError: `line 5: invalid address "0x12"`,
+		},
+		{
+			Name:        "KO - Missing amount",
+			Input:       first.Hex() + "\n",
+			ExpectError: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			amounts, err := base.ParseDisperseCSV(strings.NewReader(tt.Input), tt.Decimals)
+			if tt.ExpectError {
+				assert.Error(t, err)
+				if tt.ExpectedError != "" {
+					assert.ErrorContains(t, err, tt.ExpectedError)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.ExpectedResult, amounts)
+		})
+	}
+}
+
+// Test_SplitEvenly verifies that the division remainder is handed out instead of being lost.
+func Test_SplitEvenly(t *testing.T) {
+	recipients := []common.Address{
+		common.HexToAddress("0x1001"),
+		common.HexToAddress("0x1002"),
+		common.HexToAddress("0x1003"),
+	}
+	amounts := base.SplitEvenly(big.NewInt(11), recipients)
+	assert.Equal(t, map[common.Address]*big.Int{
+		recipients[0]: big.NewInt(4),
+		recipients[1]: big.NewInt(4),
+		recipients[2]: big.NewInt(3),
+	}, amounts)
+}
+
+// Test_DisperseETH verifies the deployment and registration of the Disperse contract, and the dispersal
+// of explicit amounts in gas-limited batches.
+func Test_DisperseETH(t *testing.T) {
+	backend, auth, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+	// A contract refusing Ether, making the dispersals to it revert.
+	refusing, err := testingtools.DeployRuntime(auth, backend, []byte{0x5f, 0x5f, 0xfd}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stop := testingtools.AutoCommit(backend, 20*time.Millisecond)
+	defer stop()
+
+	ctx := context.Background()
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	baseInteractions.SetPollInterval(10 * time.Millisecond)
+
+	assert.Error(t, baseInteractions.SetDisperse("0x1234"))
+	_, err = baseInteractions.DisperseETH(map[common.Address]*big.Int{common.HexToAddress("0x1001"): common.Big1}, 0)
+	assert.ErrorIs(t, err, base.ErrDisperseNotSet)
+
+	disperse, err := baseInteractions.DeployDisperse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registered, ok := base.RegisteredDisperse(hex.TestChainID)
+	assert.True(t, ok)
+	assert.Equal(t, disperse, registered)
+
+	// Other interactions on the chain fall back to the registered contract.
+	other := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	other.SetPollInterval(10 * time.Millisecond)
+	assert.Nil(t, other.SetDisperse(""))
+	address, err := other.DisperseAddress(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, disperse, address)
+
+	amounts := map[common.Address]*big.Int{}
+	for idx := range 6 {
+		amounts[common.BigToAddress(big.NewInt(int64(0x2000+idx)))] = big.NewInt(int64(1000 + idx))
+	}
+	amounts[common.HexToAddress("0x2100")] = new(big.Int)
+
+	testCases := []struct {
+		Name          string
+		Amounts       map[common.Address]*big.Int
+		MaxBatchGas   uint64
+		Batches       int
+		ExpectError   bool
+		ExpectedError error
+	}{
+		{
+			Name:          "KO - Nothing to disperse",
+			Amounts:       map[common.Address]*big.Int{common.HexToAddress("0x2100"): new(big.Int)},
+			ExpectError:   true,
+			ExpectedError: base.ErrNothingToDisperse,
+		},
+		{
+			Name:          "KO - Recipient over the batch gas limit",
+			Amounts:       amounts,
+			MaxBatchGas:   21_000,
+			ExpectError:   true,
+			ExpectedError: base.ErrBatchTooLarge,
+		},
+		{
+			Name:    "OK - Single batch",
+			Amounts: amounts,
+			Batches: 1,
+		},
+		{
+			Name:        "OK - Split batches",
+			Amounts:     amounts,
+			MaxBatchGas: 60_000,
+		},
+	}
+
+	dispersed := int64(0)
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			receipts, err := other.DisperseETH(tt.Amounts, tt.MaxBatchGas)
+			if tt.ExpectError {
+				assert.ErrorIs(t, err, tt.ExpectedError)
+				return
+			}
+			assert.Nil(t, err)
+			if tt.Batches != 0 {
+				assert.Equal(t, tt.Batches, len(receipts))
+			} else {
+				assert.Greater(t, len(receipts), 1)
+			}
+			for _, receipt := range receipts {
+				if tt.MaxBatchGas != 0 {
+					assert.LessOrEqual(t, receipt.GasUsed, tt.MaxBatchGas)
+				}
+			}
+
+			dispersed++
+			for recipient, amount := range tt.Amounts {
+				balance, err := backend.Client().BalanceAt(ctx, recipient, nil)
+				assert.Nil(t, err)
+				assert.Equal(t, 0, new(big.Int).Mul(amount, big.NewInt(dispersed)).Cmp(balance))
+			}
+		})
+	}
+
+	// Nothing is sent when the balance does not cover the amounts, or the fees on top of them, nor when
+	// a recipient reverts.
+	balance, err := backend.Client().PendingBalanceAt(ctx, other.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	half := new(big.Int).Rsh(balance, 1)
+	failures := []struct {
+		Name          string
+		Amounts       map[common.Address]*big.Int
+		ExpectedError error
+	}{
+		{
+			Name: "KO - Amounts over the balance",
+			Amounts: map[common.Address]*big.Int{
+				common.HexToAddress("0x4001"): balance,
+				common.HexToAddress("0x4002"): common.Big1,
+			},
+			ExpectedError: base.ErrInsufficientFunds,
+		},
+		{
+			Name: "KO - Fees over the balance",
+			Amounts: map[common.Address]*big.Int{
+				common.HexToAddress("0x4001"): half,
+				common.HexToAddress("0x4002"): new(big.Int).Sub(balance, half),
+			},
+			ExpectedError: base.ErrInsufficientFunds,
+		},
+		{
+			Name: "KO - Recipient reverting",
+			Amounts: map[common.Address]*big.Int{
+				common.HexToAddress("0x4001"): common.Big1,
+				*refusing:                     common.Big1,
+				common.HexToAddress("0x4002"): common.Big1,
+			},
+		},
+	}
+	for _, tt := range failures {
+		t.Run(tt.Name, func(t *testing.T) {
+			nonce, err := backend.Client().PendingNonceAt(ctx, other.Address)
+			if err != nil {
+				t.Fatal(err)
+			}
+			receipts, err := other.DisperseETH(tt.Amounts, 0)
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, base.ErrBatchTooLarge)
+			if tt.ExpectedError != nil {
+				assert.ErrorIs(t, err, tt.ExpectedError)
+			}
+			assert.Empty(t, receipts)
+			after, err := backend.Client().PendingNonceAt(ctx, other.Address)
+			assert.Nil(t, err)
+			assert.Equal(t, nonce, after)
+		})
+	}
+
+	// The legacy even split keeps the remainder.
+	recipients := []common.Address{common.HexToAddress("0x3001"), common.HexToAddress("0x3002")}
+	_, err = other.Disperse(recipients, 3)
+	assert.Nil(t, err)
+	for idx, expected := range []int64{2, 1} {
+		balance, err := backend.Client().BalanceAt(ctx, recipients[idx], nil)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, balance.Int64())
+	}
+}
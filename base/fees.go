@@ -0,0 +1,142 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// FeeMode selects how transaction fees are priced.
+type FeeMode int
+
+const (
+	// DynamicFee prices transactions as EIP-1559 dynamic-fee transactions.
+	DynamicFee FeeMode = iota
+	// LegacyFee prices transactions with a single gas price, for chains without EIP-1559 support.
+	LegacyFee
+)
+
+// DefaultMaxFeeMultiplier is the number of base fees covered by the max fee of a dynamic-fee transaction.
+const DefaultMaxFeeMultiplier = 2
+
+// Fees holds the pricing fields of a transaction. GasPrice is set for legacy
+// transactions, GasTipCap and GasFeeCap for dynamic-fee transactions.
+type Fees struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// IsDynamic reports whether the fees describe an EIP-1559 transaction.
+func (f *Fees) IsDynamic() bool {
+	return f.GasFeeCap != nil
+}
+
+// MaxPrice returns the highest price per gas the transaction may pay.
+func (f *Fees) MaxPrice() *big.Int {
+	if f.IsDynamic() {
+		return f.GasFeeCap
+	}
+	return f.GasPrice
+}
+
+// apply copies the fees into transaction options.
+func (f *Fees) apply(opts *bind.TransactOpts) {
+	opts.GasPrice = f.GasPrice
+	opts.GasTipCap = f.GasTipCap
+	opts.GasFeeCap = f.GasFeeCap
+}
+
+// SetFeeMode sets how fees are priced for subsequent transactions.
+func (i *Interactions) SetFeeMode(mode FeeMode) {
+	i.feeMode = mode
+}
+
+// FeeMode returns how fees are priced for transactions.
+func (i *Interactions) FeeMode() FeeMode {
+	return i.feeMode
+}
+
+// SetMaxFeeMultiplier sets the number of base fees covered by the max fee of dynamic-fee transactions.
+func (i *Interactions) SetMaxFeeMultiplier(multiplier uint64) error {
+	if multiplier == 0 {
+		return fmt.Errorf("max fee multiplier must be positive")
+	}
+	i.maxFeeMultiplier = multiplier
+	return nil
+}
+
+// SuggestFees computes the fees of the next transaction. In DynamicFee mode the max fee is the
+// latest base fee times the max fee multiplier plus the suggested tip; it falls back to a legacy
+// gas price when the latest header carries no base fee.
+func (i *Interactions) SuggestFees(ctx context.Context) (*Fees, error) {
+	if i.feeMode == LegacyFee {
+		return i.suggestLegacyFees(ctx)
+	}
+
+	header, err := i.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest header: %w", err)
+	}
+	if header.BaseFee == nil {
+		return i.suggestLegacyFees(ctx)
+	}
+
+	tip, err := i.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest gas tip cap: %w", err)
+	}
+
+	multiplier := i.maxFeeMultiplier
+	if multiplier == 0 {
+		multiplier = DefaultMaxFeeMultiplier
+	}
+	feeCap := new(big.Int).Mul(header.BaseFee, new(big.Int).SetUint64(multiplier))
+	feeCap.Add(feeCap, tip)
+
+	return &Fees{GasTipCap: tip, GasFeeCap: feeCap}, nil
+}
+
+func (i *Interactions) suggestLegacyFees(ctx context.Context) (*Fees, error) {
+	gasPrice, err := i.Client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest gas price: %w", err)
+	}
+	return &Fees{GasPrice: gasPrice}, nil
+}
+
+// newTx builds an unsigned transaction of the type matching the given fees.
+func newTx(
+	chainID *big.Int,
+	nonce uint64,
+	to *common.Address,
+	value *big.Int,
+	gasLimit uint64,
+	data []byte,
+	fees *Fees,
+) *ethTypes.Transaction {
+	if fees.IsDynamic() {
+		return ethTypes.NewTx(&ethTypes.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     nonce,
+			GasTipCap: fees.GasTipCap,
+			GasFeeCap: fees.GasFeeCap,
+			Gas:       gasLimit,
+			To:        to,
+			Value:     value,
+			Data:      data,
+		})
+	}
+	return ethTypes.NewTx(&ethTypes.LegacyTx{
+		Nonce:    nonce,
+		To:       to,
+		Value:    value,
+		Gas:      gasLimit,
+		GasPrice: fees.GasPrice,
+		Data:     data,
+	})
+}
@@ -0,0 +1,104 @@
+package base_test
+
+// Package base_test contains tests for base interactions.
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_TransferETHFeeModes verifies that Ether transfers are built as dynamic-fee
+// transactions by default and as legacy transactions in legacy mode.
+func Test_TransferETHFeeModes(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	testCases := []struct {
+		Name         string
+		Mode         base.FeeMode
+		ExpectedType uint8
+	}{
+		{
+			Name:         "OK - Dynamic fee transfer",
+			Mode:         base.DynamicFee,
+			ExpectedType: ethTypes.DynamicFeeTxType,
+		},
+		{
+			Name:         "OK - Legacy transfer",
+			Mode:         base.LegacyFee,
+			ExpectedType: ethTypes.LegacyTxType,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+			baseInteractions.SetFeeMode(tt.Mode)
+
+			to := common.HexToAddress("0x1234")
+			tx, err := baseInteractions.TransferETH(to, big.NewInt(1e18))
+			assert.Nil(t, err)
+			backend.Commit()
+			assert.Equal(t, tt.ExpectedType, tx.Type())
+
+			receipt, err := backend.Client().TransactionReceipt(baseInteractions.Ctx, tx.Hash())
+			assert.Nil(t, err)
+			assert.Equal(t, ethTypes.ReceiptStatusSuccessful, receipt.Status)
+		})
+	}
+}
+
+// Test_BaseTxSetupFees verifies that transaction options carry the fee fields of the selected mode
+// and that the max fee honours the configured multiplier.
+func Test_BaseTxSetupFees(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	assert.Nil(t, baseInteractions.SetMaxFeeMultiplier(3))
+	assert.Error(t, baseInteractions.SetMaxFeeMultiplier(0))
+
+	header, err := backend.Client().HeaderByNumber(baseInteractions.Ctx, nil)
+	assert.Nil(t, err)
+
+	opts, err := baseInteractions.BaseTxSetup()
+	assert.Nil(t, err)
+	assert.Nil(t, opts.GasPrice)
+	expectedCap := new(big.Int).Mul(header.BaseFee, big.NewInt(3))
+	expectedCap.Add(expectedCap, opts.GasTipCap)
+	assert.Equal(t, expectedCap, opts.GasFeeCap)
+
+	baseInteractions.SetFeeMode(base.LegacyFee)
+	opts, err = baseInteractions.BaseTxSetup()
+	assert.Nil(t, err)
+	assert.NotNil(t, opts.GasPrice)
+	assert.Nil(t, opts.GasFeeCap)
+	assert.Nil(t, opts.GasTipCap)
+}
@@ -0,0 +1,27 @@
+package base
+
+import (
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SetMulticall enables batching of the helpers' reads through the Multicall3 contract at address,
+// aggregating up to chunkSize calls per request (transaction.DefaultMulticallChunkSize when lower than 1).
+func (i *Interactions) SetMulticall(address common.Address, chunkSize int) {
+	i.multicall = &address
+	i.multicallChunkSize = chunkSize
+}
+
+// DisableMulticall makes the helpers issue one call per read.
+func (i *Interactions) DisableMulticall() {
+	i.multicall = nil
+}
+
+// NewMulticall returns a batcher calling with callOpts, or nil when multicall batching is disabled.
+func (i *Interactions) NewMulticall(callOpts *bind.CallOpts) *transaction.Multicall {
+	if i.multicall == nil {
+		return nil
+	}
+	return transaction.NewMulticall(i.Backend(), *i.multicall, callOpts, i.multicallChunkSize)
+}
@@ -0,0 +1,143 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// nonceErrors lists the node error messages signalling that the local nonce is out of sync.
+var nonceErrors = []string{
+	"nonce too low",
+	"nonce too high",
+	"replacement transaction underpriced",
+	"already known",
+}
+
+// IsNonceError reports whether err was caused by a nonce out of sync with the node.
+func IsNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, nonceErr := range nonceErrors {
+		if strings.Contains(msg, nonceErr) {
+			return true
+		}
+	}
+	return false
+}
+
+// NonceManager hands out monotonically increasing nonces for a single account so that
+// transactions can be sent concurrently from the same signer without colliding.
+type NonceManager struct {
+	mu       sync.Mutex
+	client   ethereum.PendingStateReader
+	address  common.Address
+	next     uint64
+	synced   bool
+	released []uint64
+}
+
+// NewNonceManager creates a nonce manager for address. The first nonce is read from the node's
+// pending state on the first call to Next.
+func NewNonceManager(client ethereum.PendingStateReader, address common.Address) *NonceManager {
+	return &NonceManager{client: client, address: address}
+}
+
+// Next returns the next nonce to use, reusing released nonces first.
+func (m *NonceManager) Next(ctx context.Context) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		if err := m.resync(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(m.released) > 0 {
+		nonce := m.released[0]
+		m.released = m.released[1:]
+		return nonce, nil
+	}
+
+	nonce := m.next
+	m.next++
+	return nonce, nil
+}
+
+// Release returns a nonce whose transaction failed before broadcast so it can be handed out again.
+func (m *NonceManager) Release(nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced || nonce >= m.next {
+		return
+	}
+
+	if nonce+1 == m.next {
+		m.next--
+		// Collapse released nonces that now sit at the top of the range.
+		for len(m.released) > 0 && m.released[len(m.released)-1]+1 == m.next {
+			m.next--
+			m.released = m.released[:len(m.released)-1]
+		}
+		return
+	}
+
+	idx := sort.Search(len(m.released), func(i int) bool { return m.released[i] >= nonce })
+	if idx < len(m.released) && m.released[idx] == nonce {
+		return
+	}
+	m.released = append(m.released, 0)
+	copy(m.released[idx+1:], m.released[idx:])
+	m.released[idx] = nonce
+}
+
+// Resync discards the local state and reads the pending nonce from the node.
+func (m *NonceManager) Resync(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.resync(ctx)
+}
+
+// Reset marks the manager as out of sync so that the next call to Next reads the node's nonce.
+func (m *NonceManager) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.synced = false
+	m.released = nil
+}
+
+func (m *NonceManager) resync(ctx context.Context) error {
+	nonce, err := m.client.PendingNonceAt(ctx, m.address)
+	if err != nil {
+		m.synced = false
+		return fmt.Errorf("failed to get user nonce: %w", err)
+	}
+	m.next = nonce
+	m.released = nil
+	m.synced = true
+	return nil
+}
+
+// Nonces returns the nonce manager of the interactions' account.
+func (i *Interactions) Nonces() *NonceManager {
+	return i.nonces
+}
+
+// ReleaseNonce is called when a transaction using nonce failed before being broadcast. Nonce errors
+// trigger a resync with the node; any other failure makes the nonce available again.
+func (i *Interactions) ReleaseNonce(nonce uint64, err error) {
+	if IsNonceError(err) {
+		i.nonces.Reset()
+		return
+	}
+	i.nonces.Release(nonce)
+}
@@ -0,0 +1,109 @@
+package base_test
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_ConcurrentTransfers verifies that concurrent transfers from the same interactions
+// receive distinct nonces and are all mined.
+func Test_ConcurrentTransfers(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+
+	const transfers = 10
+	txs := make([]*ethTypes.Transaction, transfers)
+	errs := make([]error, transfers)
+
+	var wg sync.WaitGroup
+	for idx := range transfers {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			to := common.BigToAddress(big.NewInt(int64(0x1000 + idx)))
+			txs[idx], errs[idx] = baseInteractions.TransferETH(to, common.Big1)
+		}(idx)
+	}
+	wg.Wait()
+	backend.Commit()
+
+	nonces := map[uint64]bool{}
+	for idx, tx := range txs {
+		if !assert.Nil(t, errs[idx]) {
+			continue
+		}
+		assert.False(t, nonces[tx.Nonce()], "nonce %d handed out twice", tx.Nonce())
+		nonces[tx.Nonce()] = true
+
+		receipt, err := backend.Client().TransactionReceipt(baseInteractions.Ctx, tx.Hash())
+		assert.Nil(t, err)
+		assert.Equal(t, ethTypes.ReceiptStatusSuccessful, receipt.Status)
+	}
+}
+
+// Test_NonceManagerRelease verifies that released nonces are handed out again before new ones.
+func Test_NonceManagerRelease(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	manager := baseInteractions.Nonces()
+	ctx := baseInteractions.Ctx
+
+	first, err := manager.Next(ctx)
+	assert.Nil(t, err)
+	second, _ := manager.Next(ctx)
+	third, _ := manager.Next(ctx)
+	assert.Equal(t, first+1, second)
+	assert.Equal(t, second+1, third)
+
+	manager.Release(first)
+	reused, _ := manager.Next(ctx)
+	assert.Equal(t, first, reused)
+
+	manager.Release(third)
+	manager.Release(second)
+	next, _ := manager.Next(ctx)
+	assert.Equal(t, second, next)
+
+	baseInteractions.ReleaseNonce(next, errNonceTooLow)
+	resynced, err := manager.Next(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, first, resynced)
+}
+
+type nonceError string
+
+func (e nonceError) Error() string { return string(e) }
+
+const errNonceTooLow = nonceError("nonce too low: address 0x0, tx: 0 state: 1")
@@ -0,0 +1,256 @@
+package base
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/signer"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+var (
+	// ErrNilClient is returned when the interactions are built without a client.
+	ErrNilClient = errors.New("client cannot be nil")
+	// ErrNoSigner is returned when the interactions are built without a signer or private key.
+	ErrNoSigner = errors.New("no signer configured")
+	// ErrInvalidKey is returned when the provided private key is not an ECDSA key.
+	ErrInvalidKey = errors.New("private key is not an ECDSA key")
+)
+
+// ConnectivityError is returned when the initial connectivity probe of the client kept failing.
+type ConnectivityError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *ConnectivityError) Error() string {
+	return fmt.Sprintf("client unreachable after %d attempt(s): %s", e.Attempts, e.Err.Error())
+}
+
+// Unwrap returns the error of the last probe
+func (e *ConnectivityError) Unwrap() error { return e.Err }
+
+// RetryPolicy configures the initial connectivity probe. The delay doubles after every failed
+// attempt, without exceeding MaxDelay when it is set.
+type RetryPolicy struct {
+	Attempts int
+	Delay    time.Duration
+	MaxDelay time.Duration
+}
+
+// options holds the configuration collected by the functional options of NewInteractions.
+type options struct {
+	ctx         context.Context
+	explorer    *string
+	safe        bool
+	middlewares []transaction.TxOptsMiddlewareFunc
+	signer      signer.Signer
+	retry       RetryPolicy
+	multicall   *common.Address
+	chunkSize   int
+	batching    bool
+	batchCaller transaction.BatchCaller
+	window      time.Duration
+	batchSize   int
+}
+
+// Option configures the interactions built by NewInteractions.
+type Option func(*options) error
+
+// WithContext sets the context used by the interactions, the connectivity probe included.
+func WithContext(ctx context.Context) Option {
+	return func(o *options) error {
+		if ctx == nil {
+			return errors.New("context cannot be nil")
+		}
+		o.ctx = ctx
+		return nil
+	}
+}
+
+// WithExplorer sets the explorer URL format used to report transactions.
+func WithExplorer(explorer string) Option {
+	return func(o *options) error {
+		o.explorer = &explorer
+		return nil
+	}
+}
+
+// WithSafe enables or disables the safe mode, simulating transactions before sending them.
+func WithSafe(safe bool) Option {
+	return func(o *options) error {
+		o.safe = safe
+		return nil
+	}
+}
+
+// WithMiddlewares appends transaction options middlewares, applied in order by BaseTxSetup.
+func WithMiddlewares(middlewares ...transaction.TxOptsMiddlewareFunc) Option {
+	return func(o *options) error {
+		for _, middleware := range middlewares {
+			if middleware != nil {
+				o.middlewares = append(o.middlewares, middleware)
+			}
+		}
+		return nil
+	}
+}
+
+// WithSigner sets the signer authorizing the transactions and messages.
+func WithSigner(s signer.Signer) Option {
+	return func(o *options) error {
+		if s == nil {
+			return ErrNoSigner
+		}
+		o.signer = s
+		return nil
+	}
+}
+
+// WithPrivateKey signs with an in-memory private key, which must be an ECDSA key.
+func WithPrivateKey(pk crypto.PrivateKey) Option {
+	return func(o *options) error {
+		ecdsaKey, ok := pk.(*ecdsa.PrivateKey)
+		if !ok || ecdsaKey == nil {
+			return ErrInvalidKey
+		}
+		keySigner, err := signer.NewKeySigner(ecdsaKey)
+		if err != nil {
+			return err
+		}
+		o.signer = keySigner
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the initial connectivity probe.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) error {
+		if policy.Attempts < 0 || policy.Delay < 0 || policy.MaxDelay < 0 {
+			return fmt.Errorf("invalid retry policy: %+v", policy)
+		}
+		o.retry = policy
+		return nil
+	}
+}
+
+// WithMulticall enables batching of reads through the Multicall3 contract at address.
+func WithMulticall(address common.Address, chunkSize int) Option {
+	return func(o *options) error {
+		o.multicall = &address
+		o.chunkSize = chunkSize
+		return nil
+	}
+}
+
+// WithRPCBatching coalesces the contract reads issued within window into JSON-RPC batches of at most
+// maxSize calls sent through caller. A nil caller uses the rpc.Client backing the client.
+func WithRPCBatching(caller transaction.BatchCaller, window time.Duration, maxSize int) Option {
+	return func(o *options) error {
+		o.batching = true
+		o.batchCaller = caller
+		o.window = window
+		o.batchSize = maxSize
+		return nil
+	}
+}
+
+// NewInteractions creates a new instance of Interactions configured by the given options. A signer
+// is required, and the client is probed according to the retry policy before returning.
+func NewInteractions(client simulated.Client, opts ...Option) (*Interactions, error) {
+	if client == nil {
+		return nil, ErrNilClient
+	}
+
+	o := &options{ctx: context.TODO()}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	if o.signer == nil {
+		return nil, ErrNoSigner
+	}
+
+	if err := probe(o.ctx, client, o.retry); err != nil {
+		return nil, err
+	}
+
+	fromAddress := o.signer.Address()
+	i := &Interactions{
+		Ctx:                o.ctx,
+		Client:             client,
+		Address:            fromAddress,
+		signer:             o.signer,
+		explorer:           o.explorer,
+		TxOptsFn:           chainMiddlewares(o.middlewares),
+		safe:               o.safe,
+		feeMode:            DynamicFee,
+		maxFeeMultiplier:   DefaultMaxFeeMultiplier,
+		nonces:             NewNonceManager(client, fromAddress),
+		pollInterval:       DefaultPollInterval,
+		multicall:          o.multicall,
+		multicallChunkSize: o.chunkSize,
+	}
+	if o.batching {
+		if err := i.EnableRPCBatching(o.batchCaller, o.window, o.batchSize); err != nil {
+			return nil, err
+		}
+	}
+	return i, nil
+}
+
+// probe checks that the client answers, retrying according to the policy.
+func probe(ctx context.Context, client simulated.Client, policy RetryPolicy) error {
+	attempts := max(policy.Attempts, 1)
+	delay := policy.Delay
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if _, err = client.BlockNumber(ctx); err == nil {
+			return nil
+		}
+		if attempt == attempts {
+			return &ConnectivityError{Attempts: attempt, Err: err}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &ConnectivityError{Attempts: attempt, Err: ctx.Err()}
+		case <-timer.C:
+		}
+
+		delay *= 2
+		if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+	}
+}
+
+// chainMiddlewares composes the middlewares into a single one, or returns nil when there is none.
+func chainMiddlewares(middlewares []transaction.TxOptsMiddlewareFunc) transaction.TxOptsMiddlewareFunc {
+	switch len(middlewares) {
+	case 0:
+		return nil
+	case 1:
+		return middlewares[0]
+	}
+	return func(opts *bind.TransactOpts) (*bind.TransactOpts, error) {
+		var err error
+		for _, middleware := range middlewares {
+			if opts, err = middleware(opts); err != nil {
+				return nil, err
+			}
+		}
+		return opts, nil
+	}
+}
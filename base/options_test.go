@@ -0,0 +1,152 @@
+package base_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+var errUnreachable = errors.New("connection refused")
+
+// flakyClient fails the first BlockNumber calls before delegating to the wrapped client.
+type flakyClient struct {
+	simulated.Client
+	failures int
+	calls    int
+}
+
+func (c *flakyClient) BlockNumber(ctx context.Context) (uint64, error) {
+	c.calls++
+	if c.calls <= c.failures {
+		return 0, errUnreachable
+	}
+	return c.Client.BlockNumber(ctx)
+}
+
+// Test_NewInteractions verifies the option validation and the retry policy of the connectivity probe.
+func Test_NewInteractions(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	retry := base.WithRetryPolicy(base.RetryPolicy{Attempts: 3, Delay: time.Millisecond})
+	_, edKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Failures      int
+		Options       []base.Option
+		ExpectError   bool
+		ExpectedError error
+	}{
+		{
+			Name:    "OK - Reachable client",
+			Options: []base.Option{base.WithPrivateKey(privKey)},
+		},
+		{
+			Name:     "OK - Probe succeeds after retries",
+			Failures: 2,
+			Options:  []base.Option{base.WithPrivateKey(privKey), retry},
+		},
+		{
+			Name:          "NOK - Probe exhausts retries",
+			Failures:      3,
+			Options:       []base.Option{base.WithPrivateKey(privKey), retry},
+			ExpectError:   true,
+			ExpectedError: errUnreachable,
+		},
+		{
+			Name:          "NOK - Missing signer",
+			ExpectError:   true,
+			ExpectedError: base.ErrNoSigner,
+		},
+		{
+			Name:          "NOK - Non ECDSA key",
+			Options:       []base.Option{base.WithPrivateKey(edKey)},
+			ExpectError:   true,
+			ExpectedError: base.ErrInvalidKey,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			client := &flakyClient{Client: backend.Client(), failures: tt.Failures}
+			baseInteractions, err := base.NewInteractions(client, tt.Options...)
+			if tt.ExpectError {
+				assert.ErrorIs(t, err, tt.ExpectedError)
+				assert.Nil(t, baseInteractions)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), baseInteractions.Address)
+			assert.Equal(t, tt.Failures+1, client.calls)
+		})
+	}
+
+	_, err = base.NewInteractions(&flakyClient{Client: backend.Client(), failures: 1}, base.WithPrivateKey(privKey))
+	var connErr *base.ConnectivityError
+	assert.ErrorAs(t, err, &connErr)
+	assert.Equal(t, 1, connErr.Attempts)
+}
+
+// Test_NewInteractionsMiddlewares verifies that middlewares are applied in order by BaseTxSetup.
+func Test_NewInteractionsMiddlewares(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	setValue := func(v int64) func(*bind.TransactOpts) (*bind.TransactOpts, error) {
+		return func(opts *bind.TransactOpts) (*bind.TransactOpts, error) {
+			opts.Value = big.NewInt(v)
+			return opts, nil
+		}
+	}
+
+	baseInteractions, err := base.NewInteractions(backend.Client(),
+		base.WithPrivateKey(privKey),
+		base.WithContext(context.Background()),
+		base.WithExplorer("https://explorer%s%s"),
+		base.WithSafe(true),
+		base.WithMiddlewares(setValue(1), setValue(2)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, baseInteractions.Safe())
+
+	opts, err := baseInteractions.BaseTxSetup()
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(2), opts.Value)
+}
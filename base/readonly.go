@@ -0,0 +1,19 @@
+package base
+
+import "errors"
+
+// ErrReadOnly is returned when a transaction is attempted through read-only interactions.
+var ErrReadOnly = errors.New("interactions are read-only")
+
+// ReadOnly returns a copy of the interactions refusing to sign or send transactions. The copy
+// shares the client, signer and nonce manager of the original.
+func (i *Interactions) ReadOnly() *Interactions {
+	view := *i
+	view.readOnly = true
+	return &view
+}
+
+// IsReadOnly returns whether the interactions refuse to send transactions.
+func (i *Interactions) IsReadOnly() bool {
+	return i.readOnly
+}
@@ -0,0 +1,178 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ErrReorged is returned when a mined transaction left the canonical chain and was not included again in time.
+var ErrReorged = errors.New("transaction receipt no longer canonical")
+
+// RevertError is returned when a mined transaction failed. Reason holds the decoded revert
+// reason obtained by replaying the call, and Data the raw revert data when available.
+type RevertError struct {
+	TxHash  common.Hash
+	Receipt *ethTypes.Receipt
+	Reason  string
+	Data    []byte
+	Err     error
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("transaction %s reverted", e.TxHash.Hex())
+	}
+	return fmt.Sprintf("transaction %s reverted: %s", e.TxHash.Hex(), e.Reason)
+}
+
+// Unwrap returns the error of the replayed call
+func (e *RevertError) Unwrap() error { return e.Err }
+
+// SetConfirmations sets the number of blocks, the inclusion block counting as the first one,
+// CatchTx waits for before reporting a transaction.
+func (i *Interactions) SetConfirmations(confirmations uint64) {
+	i.confirmations = confirmations
+}
+
+// Confirmations returns the number of blocks CatchTx waits for before reporting a transaction.
+func (i *Interactions) Confirmations() uint64 {
+	return i.confirmations
+}
+
+// WaitReceipt waits until tx, or one of its replacements, is mined and has the requested number of
+// confirmations, the inclusion block counting as the first one. The receipt's block is checked against
+// the canonical chain on every poll so that reorged transactions are waited for again. A failed
+// transaction returns its receipt along with a *RevertError.
+func (i *Interactions) WaitReceipt(
+	ctx context.Context,
+	tx *ethTypes.Transaction,
+	confirmations uint64,
+) (*ethTypes.Receipt, error) {
+	receipt, err := i.WaitMined(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if confirmations == 0 {
+		confirmations = 1
+	}
+
+	interval := i.pollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	hash := receipt.TxHash
+	for {
+		if receipt != nil {
+			canonical, err := i.isCanonical(ctx, receipt)
+			if err != nil {
+				return nil, waitError(ctx, err)
+			}
+			if !canonical {
+				receipt = nil
+			}
+		}
+
+		if receipt == nil {
+			// A receipt found again is checked on the next poll, so that a node serving it from a
+			// non-canonical block is not polled back to back.
+			if found, err := i.Client.TransactionReceipt(ctx, hash); err == nil {
+				receipt = found
+			}
+		} else {
+			head, err := i.Client.BlockNumber(ctx)
+			if err != nil {
+				return nil, waitError(ctx, err)
+			}
+			if head+1 >= receipt.BlockNumber.Uint64()+confirmations {
+				return receipt, i.checkStatus(ctx, receipt)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if receipt == nil {
+				return nil, fmt.Errorf("%w: %w", ErrReorged, ctx.Err())
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitError reports the context error instead of the transport error it caused once the context is done.
+// The transport may time out slightly before the context reports its deadline, hence the explicit check.
+func waitError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
+
+// isCanonical reports whether the block holding the receipt is still part of the canonical chain.
+func (i *Interactions) isCanonical(ctx context.Context, receipt *ethTypes.Receipt) (bool, error) {
+	header, err := i.Client.HeaderByNumber(ctx, receipt.BlockNumber)
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return header.Hash() == receipt.BlockHash, nil
+}
+
+// checkStatus returns a *RevertError for failed receipts, replaying the transaction on the parent
+// block to recover the revert reason.
+func (i *Interactions) checkStatus(ctx context.Context, receipt *ethTypes.Receipt) error {
+	if receipt.Status == ethTypes.ReceiptStatusSuccessful {
+		return nil
+	}
+
+	revertErr := &RevertError{TxHash: receipt.TxHash, Receipt: receipt}
+
+	tx, _, err := i.Client.TransactionByHash(ctx, receipt.TxHash)
+	if err != nil {
+		return revertErr
+	}
+	from, err := ethTypes.Sender(ethTypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return revertErr
+	}
+
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, common.Big1)
+	_, callErr := i.Client.CallContract(ctx, msg, parent)
+	if callErr == nil {
+		return revertErr
+	}
+
+	revertErr.Err = callErr
+	revertErr.Reason = callErr.Error()
+	if data, ok := ethclient.RevertErrorData(callErr); ok {
+		revertErr.Data = data
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			revertErr.Reason = reason
+		}
+	}
+	return revertErr
+}
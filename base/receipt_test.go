@@ -0,0 +1,143 @@
+package base_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_WaitReceiptReverted verifies that a mined but reverted transaction is reported with a typed
+// error carrying the replayed revert data.
+func Test_WaitReceiptReverted(t *testing.T) {
+	backend, _, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	baseInteractions.SetPollInterval(10 * time.Millisecond)
+
+	ctx := context.Background()
+	chainID := big.NewInt(hex.TestChainID)
+	nonce, err := backend.Client().PendingNonceAt(ctx, baseInteractions.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fees, err := baseInteractions.SuggestFees(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Transfer more tokens than the whole supply, skipping gas estimation so that the transaction is mined.
+	calldata := inferences.NewIerc20().PackTransfer(common.HexToAddress("0x1234"), hex.MaxUint256)
+	tx, err := ethTypes.SignNewTx(privKey, ethTypes.LatestSignerForChainID(chainID), &ethTypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: fees.GasTipCap,
+		GasFeeCap: fees.GasFeeCap,
+		Gas:       100_000,
+		To:        contractAddr,
+		Data:      calldata,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backend.Client().SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	receipt, err := baseInteractions.WaitReceipt(ctx, tx, 1)
+	assert.NotNil(t, receipt)
+
+	var revertErr *base.RevertError
+	if !assert.True(t, errors.As(err, &revertErr)) {
+		return
+	}
+	assert.Equal(t, tx.Hash(), revertErr.TxHash)
+	assert.Equal(t, inferences.Ierc20ERC20InsufficientBalanceErrorID().Bytes()[:4], revertErr.Data[:4])
+
+	_, err = baseInteractions.CatchTx(tx, nil)
+	assert.ErrorAs(t, err, &revertErr)
+}
+
+// Test_WaitReceiptConfirmations verifies that confirmations are awaited, that the caller's deadline
+// is honoured, and that a reorged receipt is replaced by its canonical one.
+func Test_WaitReceiptConfirmations(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	baseInteractions.SetPollInterval(10 * time.Millisecond)
+
+	tx, err := baseInteractions.TransferETH(common.HexToAddress("0x1234"), common.Big1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	_, err = baseInteractions.WaitReceipt(ctx, tx, 3)
+	cancel()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	minedReceipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent, err := backend.Client().HeaderByNumber(
+		context.Background(),
+		new(big.Int).Sub(minedReceipt.BlockNumber, common.Big1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Drop the inclusion block and mine the transaction again in a different block.
+	if err := backend.Fork(parent.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	backend.Commit()
+	backend.Commit()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	receipt, err := baseInteractions.WaitReceipt(ctx, tx, 3)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.NotEqual(t, minedReceipt.BlockHash, receipt.BlockHash)
+
+	header, err := backend.Client().HeaderByNumber(context.Background(), receipt.BlockNumber)
+	assert.Nil(t, err)
+	assert.Equal(t, header.Hash(), receipt.BlockHash)
+}
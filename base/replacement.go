@@ -0,0 +1,187 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// ReplacementBumpPercent is the minimum fee increase, in percent, nodes require to replace a pending transaction.
+	ReplacementBumpPercent = 10
+	// DefaultPollInterval is the default delay between two receipt lookups while waiting for a transaction.
+	DefaultPollInterval = time.Second
+	percentBase         = 100
+)
+
+// BumpPolicy configures the automatic fee escalation applied while waiting for a transaction to be mined.
+type BumpPolicy struct {
+	// Blocks is the number of blocks without inclusion after which fees are bumped.
+	Blocks uint64
+	// MaxBumps caps the number of replacements sent, zero meaning no limit.
+	MaxBumps int
+	// Percent is the fee increase applied on each bump, raised to ReplacementBumpPercent if lower.
+	Percent uint64
+}
+
+// SetBumpPolicy sets the automatic fee escalation policy used while waiting for transactions.
+// A nil policy disables escalation.
+func (i *Interactions) SetBumpPolicy(policy *BumpPolicy) {
+	i.bumpPolicy = policy
+}
+
+// SetPollInterval sets the delay between two receipt lookups while waiting for a transaction.
+func (i *Interactions) SetPollInterval(interval time.Duration) {
+	i.pollInterval = interval
+}
+
+// SpeedUp re-broadcasts a pending transaction with the same nonce, recipient, value and data
+// but with fees bumped enough to replace it.
+func (i *Interactions) SpeedUp(tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	return i.SpeedUpCtx(i.Ctx, tx)
+}
+
+// SpeedUpCtx re-broadcasts a pending transaction with bumped fees like SpeedUp using ctx.
+func (i *Interactions) SpeedUpCtx(ctx context.Context, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	return i.replace(ctx, tx, tx.To(), tx.Value(), tx.Data(), tx.Gas(), ReplacementBumpPercent)
+}
+
+// Cancel replaces a pending transaction with a zero-value transfer to the sender's own address.
+func (i *Interactions) Cancel(tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	return i.CancelCtx(i.Ctx, tx)
+}
+
+// CancelCtx replaces a pending transaction with a zero-value self-transfer like Cancel using ctx.
+func (i *Interactions) CancelCtx(ctx context.Context, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	self := i.Address
+	return i.replace(ctx, tx, &self, common.Big0, nil, params.TxGas, ReplacementBumpPercent)
+}
+
+// replace signs and broadcasts a transaction reusing the nonce of tx with fees bumped by percent.
+func (i *Interactions) replace(
+	ctx context.Context,
+	tx *ethTypes.Transaction,
+	to *common.Address,
+	value *big.Int,
+	data []byte,
+	gasLimit uint64,
+	percent uint64,
+) (*ethTypes.Transaction, error) {
+	if i.readOnly {
+		return nil, ErrReadOnly
+	}
+
+	suggested, err := i.SuggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := i.Client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement := newTx(chainID, tx.Nonce(), to, value, gasLimit, data, bumpFees(tx, suggested, percent))
+
+	signedTx, err := i.signer.SignTx(ctx, replacement, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the tx: %w", err)
+	}
+
+	if err := i.Client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, fmt.Errorf("failed to send the replacement tx: %w", err)
+	}
+	return signedTx, nil
+}
+
+// bumpFees returns the fees of tx raised by at least percent, or the suggested fees when higher.
+// The transaction type of tx is preserved.
+func bumpFees(tx *ethTypes.Transaction, suggested *Fees, percent uint64) *Fees {
+	if percent < ReplacementBumpPercent {
+		percent = ReplacementBumpPercent
+	}
+
+	if tx.Type() != ethTypes.DynamicFeeTxType {
+		return &Fees{GasPrice: maxBig(bump(tx.GasPrice(), percent), suggested.MaxPrice())}
+	}
+
+	tip := bump(tx.GasTipCap(), percent)
+	if suggested.GasTipCap != nil {
+		tip = maxBig(tip, suggested.GasTipCap)
+	}
+	feeCap := maxBig(bump(tx.GasFeeCap(), percent), suggested.MaxPrice())
+	return &Fees{GasTipCap: tip, GasFeeCap: maxBig(feeCap, tip)}
+}
+
+// bump returns value increased by percent, rounded up.
+func bump(value *big.Int, percent uint64) *big.Int {
+	bumped := new(big.Int).Mul(value, new(big.Int).SetUint64(percentBase+percent))
+	bumped.Add(bumped, big.NewInt(percentBase-1))
+	return bumped.Div(bumped, big.NewInt(percentBase))
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// WaitMined waits for tx, or any of its replacements, to be mined and returns the receipt.
+// When a bump policy is set, fees are escalated after the configured number of blocks without inclusion.
+func (i *Interactions) WaitMined(ctx context.Context, tx *ethTypes.Transaction) (*ethTypes.Receipt, error) {
+	interval := i.pollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastBump uint64
+	if i.bumpPolicy != nil {
+		bn, err := i.Client.BlockNumber(ctx)
+		if err != nil {
+			return nil, waitError(ctx, err)
+		}
+		lastBump = bn
+	}
+
+	candidates := []*ethTypes.Transaction{tx}
+	bumps := 0
+	for {
+		for _, candidate := range candidates {
+			if receipt, err := i.Client.TransactionReceipt(ctx, candidate.Hash()); err == nil {
+				return receipt, nil
+			}
+		}
+
+		if policy := i.bumpPolicy; policy != nil && (policy.MaxBumps == 0 || bumps < policy.MaxBumps) {
+			bn, err := i.Client.BlockNumber(ctx)
+			if err == nil && bn >= lastBump+policy.Blocks {
+				current := candidates[len(candidates)-1]
+				replacement, err := i.replace(
+					ctx, current, current.To(), current.Value(), current.Data(), current.Gas(), policy.Percent,
+				)
+				switch {
+				case err == nil:
+					candidates = append(candidates, replacement)
+					bumps++
+					lastBump = bn
+				case !IsNonceError(err):
+					return nil, fmt.Errorf("failed to bump transaction %s: %w", current.Hash().Hex(), err)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
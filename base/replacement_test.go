@@ -0,0 +1,172 @@
+package base_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+// sendStuckTx broadcasts an Ether transfer priced below the base fee so that it is never mined.
+func sendStuckTx(
+	t *testing.T,
+	client simulated.Client,
+	pk *ecdsa.PrivateKey,
+	to common.Address,
+) *ethTypes.Transaction {
+	t.Helper()
+	ctx := context.Background()
+	chainID := big.NewInt(hex.TestChainID)
+
+	nonce, err := client.PendingNonceAt(ctx, crypto.PubkeyToAddress(pk.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := ethTypes.SignNewTx(pk, ethTypes.LatestSignerForChainID(chainID), &ethTypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big1,
+		Gas:       params.TxGas,
+		To:        &to,
+		Value:     common.Big1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// Test_Replacement verifies that stuck transactions can be sped up or cancelled.
+func Test_Replacement(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	to := common.HexToAddress("0x1234")
+	testCases := []struct {
+		Name          string
+		Replace       func(*base.Interactions, *ethTypes.Transaction) (*ethTypes.Transaction, error)
+		ExpectedTo    common.Address
+		ExpectedValue *big.Int
+	}{
+		{
+			Name:          "OK - Speed up stuck transaction",
+			Replace:       (*base.Interactions).SpeedUp,
+			ExpectedTo:    to,
+			ExpectedValue: common.Big1,
+		},
+		{
+			Name:          "OK - Cancel stuck transaction",
+			Replace:       (*base.Interactions).Cancel,
+			ExpectedTo:    crypto.PubkeyToAddress(privKey.PublicKey),
+			ExpectedValue: common.Big0,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+			stuck := sendStuckTx(t, backend.Client(), privKey, to)
+			backend.Commit()
+
+			_, err := backend.Client().TransactionReceipt(baseInteractions.Ctx, stuck.Hash())
+			assert.Error(t, err, "stuck transaction should not be mined")
+
+			replacement, err := tt.Replace(baseInteractions, stuck)
+			if !assert.Nil(t, err) {
+				return
+			}
+			backend.Commit()
+
+			assert.Equal(t, stuck.Nonce(), replacement.Nonce())
+			assert.Equal(t, tt.ExpectedTo, *replacement.To())
+			assert.Equal(t, tt.ExpectedValue, replacement.Value())
+
+			receipt, err := backend.Client().TransactionReceipt(baseInteractions.Ctx, replacement.Hash())
+			assert.Nil(t, err)
+			assert.Equal(t, ethTypes.ReceiptStatusSuccessful, receipt.Status)
+		})
+	}
+	// Replacements stop with the context they are given.
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	stuck := sendStuckTx(t, backend.Client(), privKey, to)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = baseInteractions.SpeedUpCtx(ctx, stuck)
+	assert.ErrorIs(t, err, context.Canceled)
+	_, err = baseInteractions.CancelCtx(ctx, stuck)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// Test_WaitMinedBumpPolicy verifies that the wait loop escalates fees of a stuck transaction
+// until it gets mined.
+func Test_WaitMinedBumpPolicy(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	baseInteractions.SetPollInterval(10 * time.Millisecond)
+	baseInteractions.SetBumpPolicy(&base.BumpPolicy{Blocks: 2, MaxBumps: 3})
+
+	stuck := sendStuckTx(t, backend.Client(), privKey, common.HexToAddress("0x1234"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				backend.Commit()
+			}
+		}
+	}()
+
+	receipt, err := baseInteractions.WaitMined(ctx, stuck)
+	close(done)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.NotEqual(t, stuck.Hash(), receipt.TxHash)
+	assert.Equal(t, ethTypes.ReceiptStatusSuccessful, receipt.Status)
+}
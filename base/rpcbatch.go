@@ -0,0 +1,49 @@
+package base
+
+import (
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// EnableRPCBatching coalesces the contract reads issued within window into JSON-RPC batches of at
+// most maxSize calls sent through caller. A nil caller uses the rpc.Client backing the client.
+// Token interactions created afterwards read through the batching backend.
+func (i *Interactions) EnableRPCBatching(caller transaction.BatchCaller, window time.Duration, maxSize int) error {
+	backend, err := transaction.NewBatchingBackend(i.Client, caller, window, maxSize)
+	if err != nil {
+		return err
+	}
+	i.batching = backend
+	return nil
+}
+
+// RPCBatching returns whether reads are coalesced into JSON-RPC batches.
+func (i *Interactions) RPCBatching() bool {
+	return i.batching != nil
+}
+
+// Backend returns the backend contract instances are bound to: the batching backend when
+// RPC batching is enabled, the client otherwise.
+func (i *Interactions) Backend() bind.ContractBackend {
+	if i.batching != nil {
+		return i.batching
+	}
+	return i.Client
+}
+
+// RunReads runs the read functions concurrently when RPC batching is enabled, so that their calls
+// share batches, and sequentially otherwise.
+func (i *Interactions) RunReads(reads ...func()) {
+	if i.batching == nil {
+		for _, read := range reads {
+			read()
+		}
+		return
+	}
+	_ = transaction.Concurrently(len(reads), func(index int) error {
+		reads[index]()
+		return nil
+	})
+}
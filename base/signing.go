@@ -0,0 +1,38 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// SignTypedData signs EIP-712 typed data with the interactions' signer, returning a [R || S || V]
+// signature with V being 27 or 28.
+func (i *Interactions) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	return i.SignTypedDataCtx(i.Ctx, typedData)
+}
+
+// SignTypedDataCtx signs EIP-712 typed data like SignTypedData using ctx.
+func (i *Interactions) SignTypedDataCtx(ctx context.Context, typedData apitypes.TypedData) ([]byte, error) {
+	signature, err := i.signer.SignTypedData(ctx, typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign typed data: %w", err)
+	}
+	return signature, nil
+}
+
+// SignMessage signs an EIP-191 personal message with the interactions' signer, returning a [R || S || V]
+// signature with V being 27 or 28.
+func (i *Interactions) SignMessage(message []byte) ([]byte, error) {
+	return i.SignMessageCtx(i.Ctx, message)
+}
+
+// SignMessageCtx signs an EIP-191 personal message like SignMessage using ctx.
+func (i *Interactions) SignMessageCtx(ctx context.Context, message []byte) ([]byte, error) {
+	signature, err := i.signer.SignMessage(ctx, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+	return signature, nil
+}
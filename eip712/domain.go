@@ -0,0 +1,87 @@
+// Package eip712 builds, hashes and verifies EIP-712 typed data, as well as EIP-191 personal messages.
+package eip712
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// domainType is the name of the EIP-712 domain type.
+const domainType = "EIP712Domain"
+
+// Bits of the ERC-5267 fields bitmap telling which domain fields are used.
+const (
+	FieldName byte = 1 << iota
+	FieldVersion
+	FieldChainID
+	FieldVerifyingContract
+	FieldSalt
+)
+
+// DefaultFields are the domain fields used by most contracts.
+const DefaultFields = FieldName | FieldVersion | FieldChainID | FieldVerifyingContract
+
+// Domain is an EIP-712 domain, shaped as described by ERC-5267.
+type Domain struct {
+	// Fields is the ERC-5267 bitmap of the fields used by the domain.
+	Fields            byte
+	Name              string
+	Version           string
+	ChainID           *big.Int
+	VerifyingContract common.Address
+	Salt              common.Hash
+	Extensions        []*big.Int
+}
+
+// NewDomain returns a domain using the name, version, chain ID and verifying contract fields.
+func NewDomain(name, version string, chainID *big.Int, verifyingContract common.Address) *Domain {
+	return &Domain{
+		Fields:            DefaultFields,
+		Name:              name,
+		Version:           version,
+		ChainID:           chainID,
+		VerifyingContract: verifyingContract,
+	}
+}
+
+// TypedData returns the domain as EIP-712 typed data, with the type listing the used fields only.
+func (d *Domain) TypedData() (apitypes.TypedDataDomain, []apitypes.Type) {
+	var domain apitypes.TypedDataDomain
+	var fields []apitypes.Type
+	if d.Fields&FieldName != 0 {
+		domain.Name = d.Name
+		fields = append(fields, apitypes.Type{Name: "name", Type: "string"})
+	}
+	if d.Fields&FieldVersion != 0 {
+		domain.Version = d.Version
+		fields = append(fields, apitypes.Type{Name: "version", Type: "string"})
+	}
+	if d.Fields&FieldChainID != 0 {
+		domain.ChainId = (*math.HexOrDecimal256)(d.ChainID)
+		fields = append(fields, apitypes.Type{Name: "chainId", Type: "uint256"})
+	}
+	if d.Fields&FieldVerifyingContract != 0 {
+		domain.VerifyingContract = d.VerifyingContract.Hex()
+		fields = append(fields, apitypes.Type{Name: "verifyingContract", Type: "address"})
+	}
+	if d.Fields&FieldSalt != 0 {
+		domain.Salt = d.Salt.Hex()
+		fields = append(fields, apitypes.Type{Name: "salt", Type: "bytes32"})
+	}
+	return domain, fields
+}
+
+// Separator returns the domain separator, the hash of the domain.
+func (d *Domain) Separator() (common.Hash, error) {
+	domain, fields := d.TypedData()
+	typedData := apitypes.TypedData{Types: apitypes.Types{domainType: fields}, Domain: domain}
+	hash, err := typedData.HashStruct(domainType, domain.Map())
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash EIP-712 domain: %w", err)
+	}
+	return common.BytesToHash(hash), nil
+}
@@ -0,0 +1,46 @@
+package eip712
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// HashPersonal returns the EIP-191 hash of a personal message, the hash that is signed.
+func HashPersonal(message []byte) common.Hash {
+	return common.BytesToHash(accounts.TextHash(message))
+}
+
+// RecoverPersonal returns the address that signed the EIP-191 personal message.
+func RecoverPersonal(message, signature []byte) (common.Address, error) {
+	return RecoverHash(HashPersonal(message), signature)
+}
+
+// VerifyPersonal reports whether the EIP-191 personal message was signed by signer, through ERC-1271
+// when signer is a contract, such as a smart-contract wallet, and by recovering the signing key otherwise.
+func VerifyPersonal(
+	ctx context.Context,
+	client Client,
+	signer common.Address,
+	message []byte,
+	signature []byte,
+) (bool, error) {
+	code, err := client.CodeAt(ctx, signer, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get signer bytecode: %w", err)
+	}
+	if len(code) != 0 {
+		return VerifyERC1271(ctx, client, signer, HashPersonal(message), signature)
+	}
+	recovered, err := RecoverPersonal(message, signature)
+	if errors.Is(err, ErrInvalidSignature) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return recovered == signer, nil
+}
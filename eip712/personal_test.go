@@ -0,0 +1,56 @@
+package eip712_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/eip712"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_RecoverPersonal verifies the recovery and verification of the signer of personal messages.
+func Test_RecoverPersonal(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	signature, err := baseInteractions.SignMessage([]byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recovered, err := eip712.RecoverPersonal([]byte("hello"), signature)
+	assert.Nil(t, err)
+	assert.Equal(t, baseInteractions.Address, recovered)
+
+	recovered, err = eip712.RecoverPersonal([]byte("hello!"), signature)
+	assert.Nil(t, err)
+	assert.NotEqual(t, baseInteractions.Address, recovered)
+
+	_, err = eip712.RecoverPersonal([]byte("hello"), signature[:64])
+	assert.ErrorIs(t, err, eip712.ErrInvalidSignature)
+
+	valid, err := eip712.VerifyPersonal(
+		context.Background(), backend.Client(), baseInteractions.Address, []byte("hello"), signature,
+	)
+	assert.Nil(t, err)
+	assert.True(t, valid)
+	valid, err = eip712.VerifyPersonal(
+		context.Background(), backend.Client(), baseInteractions.Address, []byte("hello"), signature[:64],
+	)
+	assert.Nil(t, err)
+	assert.False(t, valid)
+}
@@ -0,0 +1,251 @@
+package eip712
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// tagName is the struct tag naming the fields of EIP-712 types, as `eip712:"name"` or
+// `eip712:"name,type"` to override the type inferred from the Go type.
+const tagName = "eip712"
+
+// maxBytesLength is the largest N of the bytesN types.
+const maxBytesLength = 32
+
+var (
+	addressType = reflect.TypeOf(common.Address{})
+	bigIntType  = reflect.TypeOf((*big.Int)(nil))
+)
+
+// FromStruct returns the typed data of message, a struct whose Go type name is the primary type. Only
+// the fields tagged with eip712 are members of the types; nested structs define their own type named
+// after their Go type. Go types map to EIP-712 types as follows: common.Address to address, *big.Int
+// to uint256, uintN and intN to themselves, []byte to bytes, [N]byte to bytesN, slices and arrays to
+// dynamic and fixed arrays.
+func FromStruct(domain *Domain, message any) (apitypes.TypedData, error) {
+	value := reflect.Indirect(reflect.ValueOf(message))
+	if value.Kind() != reflect.Struct {
+		return apitypes.TypedData{}, fmt.Errorf("message must be a struct, got %T", message)
+	}
+
+	typedDomain, domainFields := domain.TypedData()
+	types := apitypes.Types{domainType: domainFields}
+	primaryType, err := structType(types, value.Type())
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	encoded, err := encodeValue(types, value, primaryType)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	return apitypes.TypedData{
+		Types:       types,
+		PrimaryType: primaryType,
+		Domain:      typedDomain,
+		Message:     encoded.(apitypes.TypedDataMessage),
+	}, nil
+}
+
+// FromJSON parses typed data in the eth_signTypedData_v4 JSON format, checking that it can be hashed.
+func FromJSON(data []byte) (apitypes.TypedData, error) {
+	var typedData apitypes.TypedData
+	if err := json.Unmarshal(data, &typedData); err != nil {
+		return apitypes.TypedData{}, fmt.Errorf("failed to parse typed data: %w", err)
+	}
+	if _, err := Hash(typedData); err != nil {
+		return apitypes.TypedData{}, err
+	}
+	return typedData, nil
+}
+
+// TypeHash returns the hash of the encoded type of message, a struct as accepted by FromStruct.
+func TypeHash(message any) (common.Hash, error) {
+	messageType := reflect.Indirect(reflect.ValueOf(message)).Type()
+	if messageType.Kind() != reflect.Struct {
+		return common.Hash{}, fmt.Errorf("message must be a struct, got %T", message)
+	}
+	types := apitypes.Types{}
+	primaryType, err := structType(types, messageType)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	typedData := apitypes.TypedData{Types: types}
+	return common.BytesToHash(typedData.TypeHash(primaryType)), nil
+}
+
+// Hash returns the EIP-712 digest of the typed data, the hash that is signed.
+func Hash(typedData apitypes.TypedData) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// structType registers the type of the struct and of the structs it contains, returning its name.
+func structType(types apitypes.Types, t reflect.Type) (string, error) {
+	name := t.Name()
+	if name == "" {
+		return "", fmt.Errorf("anonymous structs cannot be EIP-712 types")
+	}
+	if _, ok := types[name]; ok {
+		return name, nil
+	}
+	// Registered before its fields so that recursive types terminate, apitypes rejecting them on hashing.
+	types[name] = []apitypes.Type{}
+
+	var fields []apitypes.Type
+	for idx := range t.NumField() {
+		field := t.Field(idx)
+		fieldName, fieldType, ok := parseTag(field)
+		if !ok {
+			continue
+		}
+		if fieldType == "" {
+			inferred, err := typeOf(types, field.Type)
+			if err != nil {
+				return "", fmt.Errorf("%s.%s: %w", name, field.Name, err)
+			}
+			fieldType = inferred
+		}
+		fields = append(fields, apitypes.Type{Name: fieldName, Type: fieldType})
+	}
+	if len(fields) == 0 {
+		return "", fmt.Errorf("%s has no field tagged with %s", name, tagName)
+	}
+	types[name] = fields
+	return name, nil
+}
+
+// parseTag returns the EIP-712 name and type, empty when inferred, of a struct field.
+func parseTag(field reflect.StructField) (string, string, bool) {
+	tag, ok := field.Tag.Lookup(tagName)
+	if !ok || tag == "-" || !field.IsExported() {
+		return "", "", false
+	}
+	name, fieldType, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return "", "", false
+	}
+	return name, fieldType, true
+}
+
+// typeOf returns the EIP-712 type of a Go type.
+func typeOf(types apitypes.Types, t reflect.Type) (string, error) {
+	switch t {
+	case addressType:
+		return "address", nil
+	case bigIntType:
+		return "uint256", nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.String:
+		return "string", nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("uint%d", t.Bits()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("int%d", t.Bits()), nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "bytes", nil
+		}
+		elem, err := typeOf(types, t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return elem + "[]", nil
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Len() >= 1 && t.Len() <= maxBytesLength {
+			return fmt.Sprintf("bytes%d", t.Len()), nil
+		}
+		elem, err := typeOf(types, t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s[%d]", elem, t.Len()), nil
+	case reflect.Struct:
+		return structType(types, t)
+	case reflect.Pointer:
+		if t.Elem().Kind() == reflect.Struct {
+			return structType(types, t.Elem())
+		}
+	}
+	return "", fmt.Errorf("unsupported Go type %s", t)
+}
+
+// encodeValue converts a Go value to the representation apitypes encodes for the EIP-712 type.
+func encodeValue(types apitypes.Types, value reflect.Value, eipType string) (any, error) {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil, fmt.Errorf("nil value for %s", eipType)
+		}
+		if value.Type() == bigIntType {
+			return value.Interface(), nil
+		}
+		value = value.Elem()
+	}
+	if value.Type() == addressType {
+		return value.Interface().(common.Address).Hex(), nil
+	}
+
+	switch value.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(value.Uint()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(value.Int()), nil
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 && strings.HasPrefix(eipType, "bytes") {
+			encoded := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(encoded), value)
+			return encoded, nil
+		}
+		bracket := strings.LastIndex(eipType, "[")
+		if bracket < 0 {
+			return nil, fmt.Errorf("%s value for %s", value.Kind(), eipType)
+		}
+		elemType := eipType[:bracket]
+		items := make([]any, value.Len())
+		for idx := range value.Len() {
+			item, err := encodeValue(types, value.Index(idx), elemType)
+			if err != nil {
+				return nil, err
+			}
+			items[idx] = item
+		}
+		return items, nil
+	case reflect.Struct:
+		message := apitypes.TypedDataMessage{}
+		for idx := range value.NumField() {
+			name, _, ok := parseTag(value.Type().Field(idx))
+			if !ok {
+				continue
+			}
+			item, err := encodeValue(types, value.Field(idx), memberType(types[eipType], name))
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", eipType, name, err)
+			}
+			message[name] = item
+		}
+		return message, nil
+	default:
+		return value.Interface(), nil
+	}
+}
+
+// memberType returns the type of the named member.
+func memberType(fields []apitypes.Type, name string) string {
+	for _, field := range fields {
+		if field.Name == name {
+			return field.Type
+		}
+	}
+	return ""
+}
@@ -0,0 +1,289 @@
+package eip712_test
+
+// Package eip712_test contains tests for EIP-712 typed data.
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/eip712"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Person and Mail are the types of the example of the EIP-712 specification.
+type Person struct {
+	Name   string         `eip712:"name"`
+	Wallet common.Address `eip712:"wallet"`
+}
+
+type Mail struct {
+	From     Person `eip712:"from"`
+	To       Person `eip712:"to"`
+	Contents string `eip712:"contents"`
+}
+
+type Item struct {
+	Token  common.Address `eip712:"token"`
+	Amount *big.Int       `eip712:"amount,uint128"`
+}
+
+type Order struct {
+	Maker    common.Address `eip712:"maker"`
+	Items    []Item         `eip712:"items"`
+	Expiry   uint64         `eip712:"expiry"`
+	Salt     [32]byte       `eip712:"salt"`
+	Data     []byte         `eip712:"data"`
+	Tags     [2]string      `eip712:"tags"`
+	internal string
+	Ignored  string `eip712:"-"`
+}
+
+type Untagged struct {
+	Name string
+}
+
+type Unsupported struct {
+	Values map[string]string `eip712:"values"`
+}
+
+// mailDomain is the domain of the example of the EIP-712 specification.
+var mailDomain = eip712.NewDomain(
+	"Ether Mail", "1", big.NewInt(1), common.HexToAddress("0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"),
+)
+
+var mail = Mail{
+	From:     Person{Name: "Cow", Wallet: common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")},
+	To:       Person{Name: "Bob", Wallet: common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB")},
+	Contents: "Hello, Bob!",
+}
+
+const mailJSON = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+// Test_Domain verifies the domain separator against the example of the EIP-712 specification.
+func Test_Domain(t *testing.T) {
+	separator, err := mailDomain.Separator()
+	assert.Nil(t, err)
+	assert.Equal(t, common.HexToHash("0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"), separator)
+
+	domain, fields := (&eip712.Domain{Fields: eip712.FieldName | eip712.FieldSalt, Name: "Salted"}).TypedData()
+	assert.Equal(t, "Salted", domain.Name)
+	assert.Nil(t, domain.ChainId)
+	assert.Len(t, fields, 2)
+	assert.Equal(t, "salt", fields[1].Name)
+}
+
+// Test_FromStruct verifies the typed data built from Go structs.
+func Test_FromStruct(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Message       any
+		ExpectedHash  common.Hash
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:         "OK - Specification example",
+			Message:      mail,
+			ExpectedHash: common.HexToHash("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"),
+		},
+		{
+			Name:         "OK - Pointer to struct",
+			Message:      &mail,
+			ExpectedHash: common.HexToHash("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"),
+		},
+		{
+			Name:          "NOK - Not a struct",
+			Message:       "mail",
+			ExpectError:   true,
+			ExpectedError: "message must be a struct, got string",
+		},
+		{
+			Name:          "NOK - No tagged field",
+			Message:       Untagged{Name: "name"},
+			ExpectError:   true,
+			ExpectedError: "Untagged has no field tagged with eip712",
+		},
+		{
+			Name:          "NOK - Unsupported field type",
+			Message:       Unsupported{},
+			ExpectError:   true,
+			ExpectedError: "Unsupported.Values: unsupported Go type map[string]string",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			typedData, err := eip712.FromStruct(mailDomain, tt.Message)
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+				return
+			}
+			assert.Nil(t, err)
+			hash, err := eip712.Hash(typedData)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.ExpectedHash, hash)
+		})
+	}
+}
+
+// Test_NestedArrays verifies that arrays of structs and byte fields hash like their JSON equivalent.
+func Test_NestedArrays(t *testing.T) {
+	order := Order{
+		Maker: common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Items: []Item{
+			{Token: common.HexToAddress("0x2222222222222222222222222222222222222222"), Amount: big.NewInt(10)},
+			{Token: common.HexToAddress("0x3333333333333333333333333333333333333333"), Amount: big.NewInt(20)},
+		},
+		Expiry: 1700000000,
+		Salt:   common.HexToHash("0x01"),
+		Data:   []byte{0xca, 0xfe},
+		Tags:   [2]string{"a", "b"},
+	}
+	typedData, err := eip712.FromStruct(mailDomain, order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Order", typedData.PrimaryType)
+	assert.Equal(t, "Item[]", typedData.Types["Order"][1].Type)
+	assert.Equal(t, "uint128", typedData.Types["Item"][1].Type)
+	assert.Len(t, typedData.Types["Order"], 6)
+
+	parsed, err := eip712.FromJSON([]byte(`{
+		"types": {
+			"EIP712Domain": [
+				{"name": "name", "type": "string"},
+				{"name": "version", "type": "string"},
+				{"name": "chainId", "type": "uint256"},
+				{"name": "verifyingContract", "type": "address"}
+			],
+			"Item": [{"name": "token", "type": "address"}, {"name": "amount", "type": "uint128"}],
+			"Order": [
+				{"name": "maker", "type": "address"},
+				{"name": "items", "type": "Item[]"},
+				{"name": "expiry", "type": "uint64"},
+				{"name": "salt", "type": "bytes32"},
+				{"name": "data", "type": "bytes"},
+				{"name": "tags", "type": "string[2]"}
+			]
+		},
+		"primaryType": "Order",
+		"domain": {
+			"name": "Ether Mail",
+			"version": "1",
+			"chainId": 1,
+			"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+		},
+		"message": {
+			"maker": "0x1111111111111111111111111111111111111111",
+			"items": [
+				{"token": "0x2222222222222222222222222222222222222222", "amount": "10"},
+				{"token": "0x3333333333333333333333333333333333333333", "amount": "20"}
+			],
+			"expiry": "1700000000",
+			"salt": "0x0000000000000000000000000000000000000000000000000000000000000001",
+			"data": "0xcafe",
+			"tags": ["a", "b"]
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := eip712.Hash(parsed)
+	assert.Nil(t, err)
+	hash, err := eip712.Hash(typedData)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, hash)
+}
+
+// Test_FromJSON verifies the parsing of eth_signTypedData_v4 payloads.
+func Test_FromJSON(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		JSON          string
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name: "OK - Specification example",
+			JSON: mailJSON,
+		},
+		{
+			Name:          "NOK - Malformed JSON",
+			JSON:          `{"types":`,
+			ExpectError:   true,
+			ExpectedError: "failed to parse typed data",
+		},
+		{
+			Name: "NOK - Invalid address",
+			JSON: `{"types": {"EIP712Domain": [{"name": "name", "type": "string"}],
+				"Person": [{"name": "wallet", "type": "address"}]},
+				"primaryType": "Person", "domain": {"name": "Ether Mail"}, "message": {"wallet": "cow"}}`,
+			ExpectError:   true,
+			ExpectedError: "failed to hash typed data",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			typedData, err := eip712.FromJSON([]byte(tt.JSON))
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+				return
+			}
+			assert.Nil(t, err)
+			hash, err := eip712.Hash(typedData)
+			assert.Nil(t, err)
+			assert.Equal(t, common.HexToHash("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"), hash)
+		})
+	}
+}
+
+// Test_TypeHash verifies the type hash against the encoded type of the EIP-712 specification.
+func Test_TypeHash(t *testing.T) {
+	hash, err := eip712.TypeHash(mail)
+	assert.Nil(t, err)
+	assert.Equal(t, common.HexToHash("0xa0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2"), hash)
+
+	_, err = eip712.TypeHash(42)
+	assert.ErrorContains(t, err, "message must be a struct")
+}
@@ -0,0 +1,154 @@
+package eip712
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// recoveryIDOffset is added to the recovery ID of signatures following wallet conventions.
+const recoveryIDOffset = 27
+
+// ERC1271MagicValue is returned by isValidSignature for valid signatures, its own selector.
+var ERC1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+// ErrInvalidSignature is returned for signatures that are malformed or do not recover a signer.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// erc1271 describes the isValidSignature function of ERC-1271 wallets, its ABI being parsed once.
+var erc1271 = &bind.MetaData{ABI: `[{
+    "type": "function",
+    "name": "isValidSignature",
+    "inputs": [
+    {
+        "name": "hash",
+        "type": "bytes32",
+        "internalType": "bytes32"
+    },
+    {
+        "name": "signature",
+        "type": "bytes",
+        "internalType": "bytes"
+    }
+    ],
+    "outputs": [
+    {
+        "name": "magicValue",
+        "type": "bytes4",
+        "internalType": "bytes4"
+    }
+    ],
+    "stateMutability": "view"
+}]`}
+
+// Client is the part of an Ethereum client the verification of contract signatures needs.
+type Client interface {
+	ethereum.ContractCaller
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// Recover returns the address that signed the typed data.
+func Recover(typedData apitypes.TypedData, signature []byte) (common.Address, error) {
+	hash, err := Hash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return RecoverHash(hash, signature)
+}
+
+// RecoverHash returns the address that signed the hash with a [R || S || V] signature, V being 0, 1,
+// 27 or 28. Malleable signatures, with S in the upper half of the curve order, are rejected.
+func RecoverHash(hash common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("%w: length %d", ErrInvalidSignature, len(signature))
+	}
+	sig := bytes.Clone(signature)
+	if sig[crypto.RecoveryIDOffset] >= recoveryIDOffset {
+		sig[crypto.RecoveryIDOffset] -= recoveryIDOffset
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	if !crypto.ValidateSignatureValues(sig[crypto.RecoveryIDOffset], r, s, true) {
+		return common.Address{}, ErrInvalidSignature
+	}
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// Verify reports whether the typed data was signed by signer.
+func Verify(typedData apitypes.TypedData, signature []byte, signer common.Address) (bool, error) {
+	recovered, err := Recover(typedData, signature)
+	if errors.Is(err, ErrInvalidSignature) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return recovered == signer, nil
+}
+
+// VerifyERC1271 reports whether the wallet contract accepts the signature of the hash through ERC-1271
+// isValidSignature. Calls that revert or return anything but the magic value report the signature as
+// invalid, while the errors of the node, such as transport failures, are returned.
+func VerifyERC1271(
+	ctx context.Context,
+	client Client,
+	wallet common.Address,
+	hash common.Hash,
+	signature []byte,
+) (bool, error) {
+	walletABI, err := erc1271.ParseABI()
+	if err != nil {
+		return false, err
+	}
+	data, err := walletABI.Pack("isValidSignature", hash, signature)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode isValidSignature call: %w", err)
+	}
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &wallet, Data: data}, nil)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		if _, reverted := ethclient.RevertErrorData(err); reverted {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to call isValidSignature: %w", err)
+	}
+	return len(result) == common.HashLength && bytes.Equal(result[:4], ERC1271MagicValue[:]), nil
+}
+
+// VerifySigner reports whether the typed data was signed by signer, through ERC-1271 when signer is a
+// contract, such as a smart-contract wallet, and by recovering the signing key otherwise.
+func VerifySigner(
+	ctx context.Context,
+	client Client,
+	signer common.Address,
+	typedData apitypes.TypedData,
+	signature []byte,
+) (bool, error) {
+	code, err := client.CodeAt(ctx, signer, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get signer bytecode: %w", err)
+	}
+	if len(code) == 0 {
+		return Verify(typedData, signature, signer)
+	}
+	hash, err := Hash(typedData)
+	if err != nil {
+		return false, err
+	}
+	return VerifyERC1271(ctx, client, signer, hash, signature)
+}
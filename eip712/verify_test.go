@@ -0,0 +1,215 @@
+package eip712_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/eip712"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// unreachableClient fails its calls as a node that cannot be reached.
+type unreachableClient struct {
+	eip712.Client
+}
+
+func (c *unreachableClient) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return nil, errUnreachable
+}
+
+var errUnreachable = errors.New("connection refused")
+
+// highS returns the malleable twin of a signature, valid for ecrecover but rejected by EIP-2.
+func highS(signature []byte) []byte {
+	twin := bytes.Clone(signature)
+	s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(signature[32:64]))
+	s.FillBytes(twin[32:64])
+	twin[crypto.RecoveryIDOffset] ^= 1
+	return twin
+}
+
+// Test_Verify verifies the signing of typed data and the recovery of its signer.
+func Test_Verify(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	typedData, err := eip712.FromStruct(mailDomain, mail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := baseInteractions.SignTypedData(typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := bytes.Clone(signature)
+	tampered[0] ^= 0xff
+	otherMail := mail
+	otherMail.Contents = "Hello, Alice!"
+	otherData, err := eip712.FromStruct(mailDomain, otherMail)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name      string
+		Signature []byte
+		Signer    common.Address
+		Expected  bool
+	}{
+		{
+			Name:      "OK - Signed by the signer",
+			Signature: signature,
+			Signer:    baseInteractions.Address,
+			Expected:  true,
+		},
+		{
+			Name:      "OK - Recovery ID without offset",
+			Signature: append(bytes.Clone(signature[:64]), signature[64]-27),
+			Signer:    baseInteractions.Address,
+			Expected:  true,
+		},
+		{
+			Name:      "NOK - Other signer",
+			Signature: signature,
+			Signer:    common.HexToAddress("0x1"),
+		},
+		{
+			Name:      "NOK - Tampered signature",
+			Signature: tampered,
+			Signer:    baseInteractions.Address,
+		},
+		{
+			Name:      "NOK - Malleable signature",
+			Signature: highS(signature),
+			Signer:    baseInteractions.Address,
+		},
+		{
+			Name:      "NOK - Truncated signature",
+			Signature: signature[:64],
+			Signer:    baseInteractions.Address,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			valid, err := eip712.Verify(typedData, tt.Signature, tt.Signer)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.Expected, valid)
+		})
+	}
+
+	valid, err := eip712.Verify(otherData, signature, baseInteractions.Address)
+	assert.Nil(t, err)
+	assert.False(t, valid, "signature must not verify other data")
+
+	_, err = eip712.Recover(typedData, highS(signature))
+	assert.ErrorIs(t, err, eip712.ErrInvalidSignature)
+}
+
+// Test_VerifySigner verifies signatures of accounts and smart-contract wallets.
+func Test_VerifySigner(t *testing.T) {
+	backend, auth, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	typedData, err := eip712.FromStruct(mailDomain, mail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := eip712.Hash(typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := baseInteractions.SignTypedData(typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wallet, err := testingtools.DeployERC1271Wallet(auth, backend, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rejecting, err := testingtools.DeployERC1271Wallet(auth, backend, common.Hash{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reverting, err := testingtools.DeployRuntime(auth, backend, []byte{0x5f, 0x5f, 0xfd}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name     string
+		Signer   common.Address
+		Expected bool
+	}{
+		{
+			Name:     "OK - Account signature",
+			Signer:   baseInteractions.Address,
+			Expected: true,
+		},
+		{
+			Name:     "OK - Wallet approving the hash",
+			Signer:   *wallet,
+			Expected: true,
+		},
+		{
+			Name:   "NOK - Wallet rejecting the hash",
+			Signer: *rejecting,
+		},
+		{
+			Name:   "NOK - Wallet reverting",
+			Signer: *reverting,
+		},
+		{
+			Name:   "NOK - Other account",
+			Signer: common.HexToAddress("0x1"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			valid, err := eip712.VerifySigner(context.Background(), backend.Client(), tt.Signer, typedData, signature)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.Expected, valid)
+		})
+	}
+
+	// Node failures are not mistaken for rejected signatures.
+	valid, err := eip712.VerifySigner(
+		context.Background(), &unreachableClient{backend.Client()}, *wallet, typedData, signature,
+	)
+	assert.ErrorIs(t, err, errUnreachable)
+	assert.False(t, valid)
+}
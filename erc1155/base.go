@@ -0,0 +1,327 @@
+package erc1155
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/customerrors"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type session struct {
+	erc1155  *inferences.Ierc1155
+	callOpts *bind.CallOpts
+	instance *bind.BoundContract
+}
+
+func (s *session) CallOpts() *bind.CallOpts {
+	return s.callOpts
+}
+func (s *session) Instance() *bind.BoundContract {
+	return s.instance
+}
+
+// ERC1155Interactions provides methods for interacting with ERC1155 multi-token contracts
+type ERC1155Interactions struct {
+	*base.Interactions
+	*session
+	erc1155Address common.Address
+	callError      func(string, error) error
+}
+
+// NewERC1155Interactions creates a new instance of ERC1155Interactions from a base interaction
+// interface and an ERC1155 contract address.
+func NewERC1155Interactions(
+	baseInteractions *base.Interactions,
+	address common.Address,
+	signatures []BaseERC1155Signature,
+	transactOpsMiddleware ...transaction.TxOptsMiddlewareFunc,
+) (*ERC1155Interactions, error) {
+	var converted []hex.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	if err := baseInteractions.CheckSignatures(address, converted); err != nil {
+		return nil, customerrors.WrapInterfacingError("CheckSignatures", err)
+	}
+
+	erc1155 := inferences.NewIerc1155()
+
+	erc1155Session := session{
+		erc1155:  erc1155,
+		callOpts: &bind.CallOpts{Pending: true, From: baseInteractions.Address},
+		instance: erc1155.Instance(baseInteractions.Backend(), address),
+	}
+
+	callError := base.GenCallError("erc1155", ParseError, erc1155.UnpackError)
+
+	erc1155Interactions := &ERC1155Interactions{
+		baseInteractions,
+		&erc1155Session,
+		address,
+		callError,
+	}
+
+	if len(transactOpsMiddleware) > 0 {
+		if transactOpsMiddleware[0] == nil {
+			return nil, fmt.Errorf("transactOpts cannot be nil")
+		}
+		erc1155Interactions.TxOptsFn = transactOpsMiddleware[0]
+	}
+
+	return erc1155Interactions, nil
+}
+
+// GetAddress returns the ERC1155 contract address.
+func (d *ERC1155Interactions) GetAddress() common.Address {
+	return d.erc1155Address
+}
+
+// GetSession returns the current session used for ERC1155 interactions.
+func (d *ERC1155Interactions) GetSession() transaction.Session {
+	return d.session
+}
+
+// AtBlock returns a read-only copy of the interactions whose calls are pinned to the given block.
+// A nil block number stands for the latest block, as in go-ethereum, and pins nothing.
+func (d *ERC1155Interactions) AtBlock(blockNumber *big.Int) *ERC1155Interactions {
+	callOpts := &bind.CallOpts{From: d.Address}
+	if blockNumber != nil {
+		callOpts.BlockNumber = new(big.Int).Set(blockNumber)
+	}
+	return d.withCallOpts(callOpts, true)
+}
+
+// AtBlockHash returns a read-only copy of the interactions whose calls are pinned to the given block hash.
+func (d *ERC1155Interactions) AtBlockHash(blockHash common.Hash) *ERC1155Interactions {
+	return d.withCallOpts(&bind.CallOpts{From: d.Address, BlockHash: blockHash}, true)
+}
+
+// Latest returns a copy of the interactions whose calls run against the latest block.
+// Copies of read-only views stay read-only.
+func (d *ERC1155Interactions) Latest() *ERC1155Interactions {
+	return d.withCallOpts(&bind.CallOpts{From: d.Address}, false)
+}
+
+// Pending returns a copy of the interactions whose calls run against the pending state, the default.
+// Copies of read-only views stay read-only.
+func (d *ERC1155Interactions) Pending() *ERC1155Interactions {
+	return d.withCallOpts(&bind.CallOpts{Pending: true, From: d.Address}, false)
+}
+
+// withCallOpts returns a copy of the interactions calling with callOpts, read-only if requested.
+func (d *ERC1155Interactions) withCallOpts(callOpts *bind.CallOpts, readOnly bool) *ERC1155Interactions {
+	view := *d
+	view.session = &session{
+		erc1155:  d.erc1155,
+		callOpts: callOpts,
+		instance: d.instance,
+	}
+	if readOnly {
+		view.Interactions = d.Interactions.ReadOnly()
+	}
+	return &view
+}
+
+// BalanceOf retrieves the balance of token id held by account.
+func (d *ERC1155Interactions) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return d.BalanceOfCtx(d.Ctx, account, id)
+}
+
+// BalanceOfCtx retrieves the balance of token id held by account using ctx.
+func (d *ERC1155Interactions) BalanceOfCtx(ctx context.Context, account common.Address, id *big.Int) (*big.Int, error) {
+	balance, err := transaction.CallCtx(
+		ctx,
+		d.session,
+		d.erc1155.PackBalanceOf(account, id),
+		d.erc1155.UnpackBalanceOf,
+	)
+	if err != nil {
+		return nil, d.callError("BalanceOf()", err)
+	}
+	return balance, nil
+}
+
+// BalanceOfBatch retrieves the balances of the tokens ids held by the accounts, pairing the
+// accounts and ids of the same index.
+func (d *ERC1155Interactions) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return d.BalanceOfBatchCtx(d.Ctx, accounts, ids)
+}
+
+// BalanceOfBatchCtx retrieves the balances of the tokens ids held by the accounts using ctx.
+func (d *ERC1155Interactions) BalanceOfBatchCtx(
+	ctx context.Context,
+	accounts []common.Address,
+	ids []*big.Int,
+) ([]*big.Int, error) {
+	balances, err := transaction.CallCtx(
+		ctx,
+		d.session,
+		d.erc1155.PackBalanceOfBatch(accounts, ids),
+		d.erc1155.UnpackBalanceOfBatch,
+	)
+	if err != nil {
+		return nil, d.callError("BalanceOfBatch()", err)
+	}
+	return balances, nil
+}
+
+// IsApprovedForAll returns whether operator may transfer all the tokens of account.
+func (d *ERC1155Interactions) IsApprovedForAll(account, operator common.Address) (bool, error) {
+	return d.IsApprovedForAllCtx(d.Ctx, account, operator)
+}
+
+// IsApprovedForAllCtx returns whether operator may transfer all the tokens of account using ctx.
+func (d *ERC1155Interactions) IsApprovedForAllCtx(ctx context.Context, account, operator common.Address) (bool, error) {
+	approved, err := transaction.CallCtx(
+		ctx,
+		d.session,
+		d.erc1155.PackIsApprovedForAll(account, operator),
+		d.erc1155.UnpackIsApprovedForAll,
+	)
+	if err != nil {
+		return false, d.callError("IsApprovedForAll()", err)
+	}
+	return approved, nil
+}
+
+// SetApprovalForAll grants or revokes the permission of operator to transfer all the signer's tokens.
+func (d *ERC1155Interactions) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return d.SetApprovalForAllCtx(d.Ctx, operator, approved)
+}
+
+// SetApprovalForAllCtx grants or revokes the permission of operator to transfer all the signer's
+// tokens using ctx.
+func (d *ERC1155Interactions) SetApprovalForAllCtx(
+	ctx context.Context,
+	operator common.Address,
+	approved bool,
+) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
+		d,
+		d.session,
+		d.erc1155.PackSetApprovalForAll(operator, approved),
+		transaction.DefaultUnpacker,
+	)
+	if err != nil {
+		return nil, d.callError("SetApprovalForAll()", err)
+	}
+	return tx, nil
+}
+
+// SafeTransferFrom transfers value tokens id from from to to, forwarding data to the receiver hook.
+func (d *ERC1155Interactions) SafeTransferFrom(
+	from, to common.Address,
+	id, value *big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	return d.SafeTransferFromCtx(d.Ctx, from, to, id, value, data)
+}
+
+// SafeTransferFromCtx transfers value tokens id from from to to using ctx.
+func (d *ERC1155Interactions) SafeTransferFromCtx(
+	ctx context.Context,
+	from, to common.Address,
+	id, value *big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
+		d,
+		d.session,
+		d.erc1155.PackSafeTransferFrom(from, to, id, value, data),
+		transaction.DefaultUnpacker,
+	)
+	if err != nil {
+		return nil, d.callError("SafeTransferFrom()", err)
+	}
+	return tx, nil
+}
+
+// SafeBatchTransferFrom transfers the values of the tokens ids from from to to in a single transaction,
+// pairing the ids and values of the same index.
+func (d *ERC1155Interactions) SafeBatchTransferFrom(
+	from, to common.Address,
+	ids, values []*big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	return d.SafeBatchTransferFromCtx(d.Ctx, from, to, ids, values, data)
+}
+
+// SafeBatchTransferFromCtx transfers the values of the tokens ids from from to to using ctx.
+func (d *ERC1155Interactions) SafeBatchTransferFromCtx(
+	ctx context.Context,
+	from, to common.Address,
+	ids, values []*big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
+		d,
+		d.session,
+		d.erc1155.PackSafeBatchTransferFrom(from, to, ids, values, data),
+		transaction.DefaultUnpacker,
+	)
+	if err != nil {
+		return nil, d.callError("SafeBatchTransferFrom()", err)
+	}
+	return tx, nil
+}
+
+// URI returns the metadata URI of token id, with the {id} placeholder substituted as specified by EIP-1155.
+func (d *ERC1155Interactions) URI(id *big.Int) (string, error) {
+	return d.URICtx(d.Ctx, id)
+}
+
+// URICtx returns the metadata URI of token id using ctx.
+func (d *ERC1155Interactions) URICtx(ctx context.Context, id *big.Int) (string, error) {
+	uri, err := transaction.CallCtx(
+		ctx,
+		d.session,
+		d.erc1155.PackUri(id),
+		d.erc1155.UnpackUri,
+	)
+	if err != nil {
+		return "", d.callError("URI()", err)
+	}
+	return ExpandURI(uri, id), nil
+}
+
+// ExpandURI substitutes the {id} placeholders of uri with the token id as 64 lowercase hex characters,
+// without 0x prefix, as specified by EIP-1155.
+func ExpandURI(uri string, id *big.Int) string {
+	return strings.ReplaceAll(uri, "{id}", fmt.Sprintf("%064x", id))
+}
+
+// ParseError parses raw contract errors into human-readable error messages for ERC1155 operations.
+func ParseError(rawErr any) error {
+	switch e := rawErr.(type) {
+	case *inferences.Ierc1155ERC1155InsufficientBalance:
+		return fmt.Errorf("ERC1155InsufficientBalance: sender %s, balance %s, needed %s, %s",
+			e.Sender.Hex(), e.Balance.String(), e.Needed.String(), e.TokenId.String())
+	case *inferences.Ierc1155ERC1155InvalidApprover:
+		return fmt.Errorf("ERC1155InvalidApprover: %s", e.Approver.Hex())
+	case *inferences.Ierc1155ERC1155InvalidArrayLength:
+		return fmt.Errorf("ERC1155InvalidArrayLength: %s ids, %s values", e.IdsLength.String(), e.ValuesLength.String())
+	case *inferences.Ierc1155ERC1155InvalidOperator:
+		return fmt.Errorf("ERC1155InvalidOperator: %s", e.Operator.Hex())
+	case *inferences.Ierc1155ERC1155InvalidReceiver:
+		return fmt.Errorf("ERC1155InvalidReceiver: %s", e.Receiver.Hex())
+	case *inferences.Ierc1155ERC1155InvalidSender:
+		return fmt.Errorf("ERC1155InvalidSender: %s", e.Sender.Hex())
+	case *inferences.Ierc1155ERC1155MissingApprovalForAll:
+		return fmt.Errorf("ERC1155MissingApprovalForAll: operator %s, owner %s", e.Operator.Hex(), e.Owner.Hex())
+	default:
+		return nil
+	}
+}
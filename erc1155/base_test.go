@@ -0,0 +1,324 @@
+package erc1155_test
+
+// Package erc1155_test contains tests for ERC1155 interactions defined in base.go and events.go.
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/erc1155"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+// allSignatures lists the functions implemented by the test contract.
+var allSignatures = []erc1155.BaseERC1155Signature{
+	erc1155.BalanceOf,
+	erc1155.BalanceOfBatch,
+	erc1155.SetApprovalForAll,
+	erc1155.IsApprovedForAll,
+	erc1155.SafeTransferFrom,
+	erc1155.SafeBatchTransferFrom,
+	erc1155.URI,
+}
+
+// setup deploys the bundled test contract, a hand-assembled ERC1155 without receiver hooks whose
+// constructor mints 1000 of token 1, 10 of token 2 and 1 of token 3 to the deployer.
+func setup(t *testing.T) (*simulated.Backend, *bind.TransactOpts, *erc1155.ERC1155Interactions, *ecdsa.PrivateKey) {
+	t.Helper()
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc1155MetaData.ABI,
+		inferences.Ierc1155MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	multiToken, err := erc1155.NewERC1155Interactions(baseInteractions, *contractAddr, allSignatures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return backend, auth, multiToken, privKey
+}
+
+// fundedAccount returns the interactions of a fresh account holding some ether.
+func fundedAccount(
+	t *testing.T,
+	backend *simulated.Backend,
+	multiToken *erc1155.ERC1155Interactions,
+) *erc1155.ERC1155Interactions {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := multiToken.TransferETH(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1e18)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	baseInteractions := base.NewBaseInteractions(backend.Client(), key, nil, false)
+	account, err := erc1155.NewERC1155Interactions(baseInteractions, multiToken.GetAddress(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return account
+}
+
+// int64s converts the values for comparison, big.Int zeros having several representations.
+func int64s(values []*big.Int) []int64 {
+	converted := make([]int64, len(values))
+	for idx, value := range values {
+		converted[idx] = value.Int64()
+	}
+	return converted
+}
+
+// Test_Instantiation verifies that the interactions check the signatures of the contract.
+func Test_Instantiation(t *testing.T) {
+	backend, auth, multiToken, privKey := setup(t)
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	emptyContract, err := testingtools.DeployEmptyContract(auth, backend)
+	if err != nil {
+		t.Fatalf("failed to deploy empty contract: %s", err)
+	}
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	_, err = erc1155.NewERC1155Interactions(baseInteractions, *emptyContract, allSignatures)
+	assert.Error(t, err)
+	_, err = erc1155.NewERC1155Interactions(baseInteractions, multiToken.GetAddress(), allSignatures, nil)
+	assert.Error(t, err)
+}
+
+// Test_BalanceOf verifies single and batched balance reads.
+func Test_BalanceOf(t *testing.T) {
+	backend, _, multiToken, _ := setup(t)
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	other := common.HexToAddress("0x3001")
+	tests := []struct {
+		Name     string
+		Account  common.Address
+		ID       int64
+		Expected int64
+	}{
+		{Name: "OK - Deployer token 1", Account: multiToken.Address, ID: 1, Expected: 1000},
+		{Name: "OK - Deployer token 3", Account: multiToken.Address, ID: 3, Expected: 1},
+		{Name: "OK - Unminted token", Account: multiToken.Address, ID: 4, Expected: 0},
+		{Name: "OK - Other account", Account: other, ID: 1, Expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			balance, err := multiToken.BalanceOf(tt.Account, big.NewInt(tt.ID))
+			assert.Nil(t, err)
+			assert.Equal(t, tt.Expected, balance.Int64())
+		})
+	}
+
+	balances, err := multiToken.BalanceOfBatch(
+		[]common.Address{multiToken.Address, multiToken.Address, other},
+		[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(1)},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1000, 10, 0}, int64s(balances))
+
+	_, err = multiToken.BalanceOfBatch([]common.Address{other}, []*big.Int{big.NewInt(1), big.NewInt(2)})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "erc1155.BalanceOfBatch(): ERC1155InvalidArrayLength: 2 ids, 1 values")
+	}
+}
+
+// Test_SafeTransferFrom verifies single transfers and their custom errors.
+func Test_SafeTransferFrom(t *testing.T) {
+	backend, _, multiToken, _ := setup(t)
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	recipient := common.HexToAddress("0x3001")
+	stranger := fundedAccount(t, backend, multiToken)
+
+	tests := []struct {
+		Name          string
+		Sender        *erc1155.ERC1155Interactions
+		To            common.Address
+		ID            int64
+		Value         int64
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:   "OK - Successful transfer",
+			Sender: multiToken,
+			To:     recipient,
+			ID:     1,
+			Value:  400,
+		},
+		{
+			Name:        "NOK - Insufficient balance",
+			Sender:      multiToken,
+			To:          recipient,
+			ID:          2,
+			Value:       11,
+			ExpectError: true,
+			ExpectedError: "erc1155.SafeTransferFrom(): ERC1155InsufficientBalance: sender " +
+				multiToken.Address.Hex() + ", balance 10, needed 11, 2",
+		},
+		{
+			Name:          "NOK - Zero address receiver",
+			Sender:        multiToken,
+			To:            common.Address{},
+			ID:            1,
+			Value:         1,
+			ExpectError:   true,
+			ExpectedError: "erc1155.SafeTransferFrom(): ERC1155InvalidReceiver: " + common.Address{}.Hex(),
+		},
+		{
+			Name:        "NOK - Missing approval",
+			Sender:      stranger,
+			To:          recipient,
+			ID:          1,
+			Value:       1,
+			ExpectError: true,
+			ExpectedError: "erc1155.SafeTransferFrom(): ERC1155MissingApprovalForAll: operator " +
+				stranger.Address.Hex() + ", owner " + multiToken.Address.Hex(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, err := tt.Sender.SafeTransferFrom(multiToken.Address, tt.To, big.NewInt(tt.ID), big.NewInt(tt.Value), nil)
+			backend.Commit()
+			if tt.ExpectError {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.ExpectedError)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			balance, err := multiToken.BalanceOf(tt.To, big.NewInt(tt.ID))
+			assert.Nil(t, err)
+			assert.Equal(t, tt.Value, balance.Int64())
+		})
+	}
+}
+
+// Test_ApprovalAndBatchTransfer verifies operator approvals and batched transfers.
+func Test_ApprovalAndBatchTransfer(t *testing.T) {
+	backend, _, multiToken, _ := setup(t)
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	recipient := common.HexToAddress("0x3001")
+	operator := fundedAccount(t, backend, multiToken)
+
+	_, err := multiToken.SetApprovalForAll(common.Address{}, true)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "erc1155.SetApprovalForAll(): ERC1155InvalidOperator")
+	}
+
+	_, err = multiToken.SetApprovalForAll(operator.Address, true)
+	assert.Nil(t, err)
+	backend.Commit()
+	approved, err := multiToken.IsApprovedForAll(multiToken.Address, operator.Address)
+	assert.Nil(t, err)
+	assert.True(t, approved)
+
+	ids := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	_, err = operator.SafeBatchTransferFrom(multiToken.Address, recipient, ids,
+		[]*big.Int{big.NewInt(1)}, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "erc1155.SafeBatchTransferFrom(): ERC1155InvalidArrayLength: 3 ids, 1 values")
+	}
+
+	_, err = operator.SafeBatchTransferFrom(multiToken.Address, recipient, ids,
+		[]*big.Int{big.NewInt(100), big.NewInt(10), big.NewInt(1)}, []byte("batch"))
+	assert.Nil(t, err)
+	backend.Commit()
+
+	balances, err := multiToken.BalanceOfBatch(
+		[]common.Address{recipient, recipient, recipient, multiToken.Address, multiToken.Address},
+		[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(1), big.NewInt(2)},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{100, 10, 1, 900, 0}, int64s(balances))
+
+	_, err = multiToken.SetApprovalForAll(operator.Address, false)
+	assert.Nil(t, err)
+	backend.Commit()
+	_, err = operator.SafeTransferFrom(multiToken.Address, recipient, big.NewInt(1), big.NewInt(1), nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "ERC1155MissingApprovalForAll")
+	}
+}
+
+// Test_FilterTransfers verifies that TransferSingle and TransferBatch logs are decoded into transfers.
+func Test_FilterTransfers(t *testing.T) {
+	backend, _, multiToken, _ := setup(t)
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	recipient := common.HexToAddress("0x3001")
+	_, err := multiToken.SafeTransferFrom(multiToken.Address, recipient, big.NewInt(2), big.NewInt(4), nil)
+	assert.Nil(t, err)
+	backend.Commit()
+
+	mints, err := multiToken.FilterTransfers([]common.Address{{}}, nil, 0, nil)
+	assert.Nil(t, err)
+	if assert.Len(t, mints, 3) {
+		for idx, expected := range []int64{1000, 10, 1} {
+			assert.Equal(t, multiToken.Address, mints[idx].Operator)
+			assert.Equal(t, multiToken.Address, mints[idx].To)
+			assert.Equal(t, int64(idx+1), mints[idx].ID.Int64())
+			assert.Equal(t, expected, mints[idx].Value.Int64())
+		}
+	}
+
+	received, err := multiToken.FilterTransfers(nil, []common.Address{recipient}, 0, nil)
+	assert.Nil(t, err)
+	if assert.Len(t, received, 1) {
+		assert.Equal(t, multiToken.Address, received[0].From)
+		assert.Equal(t, int64(2), received[0].ID.Int64())
+		assert.Equal(t, int64(4), received[0].Value.Int64())
+		assert.Equal(t, erc1155.TransferSingleEventID, received[0].Raw.Topics[0])
+	}
+}
+
+// Test_URI verifies the substitution of the {id} placeholder.
+func Test_URI(t *testing.T) {
+	backend, _, multiToken, _ := setup(t)
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	uri, err := multiToken.URI(big.NewInt(0x4cce))
+	assert.Nil(t, err)
+	assert.Equal(t, "https://token.example/0000000000000000000000000000000000000000000000000000000000004cce.json", uri)
+
+	assert.Equal(t, "ipfs://cid/no-placeholder.json", erc1155.ExpandURI("ipfs://cid/no-placeholder.json", big.NewInt(1)))
+}
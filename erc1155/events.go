@@ -0,0 +1,121 @@
+package erc1155
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// TransferSingleEventID is the topic of the ERC1155 TransferSingle event.
+	TransferSingleEventID = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	// TransferBatchEventID is the topic of the ERC1155 TransferBatch event.
+	TransferBatchEventID = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+	// ApprovalForAllEventID is the topic of the ERC1155 ApprovalForAll event.
+	ApprovalForAllEventID = crypto.Keccak256Hash([]byte("ApprovalForAll(address,address,bool)"))
+	// URIEventID is the topic of the ERC1155 URI event.
+	URIEventID = crypto.Keccak256Hash([]byte("URI(string,uint256)"))
+)
+
+// Transfer is the move of value tokens id, decoded from a TransferSingle log or from one entry of
+// a TransferBatch log. Mints are transfers from the zero address, burns transfers to it.
+type Transfer struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	ID       *big.Int
+	Value    *big.Int
+	Raw      *types.Log
+}
+
+// DecodeTransfers decodes a TransferSingle log, or expands a TransferBatch log into one transfer per id.
+func (d *ERC1155Interactions) DecodeTransfers(log *types.Log) ([]*Transfer, error) {
+	if len(log.Topics) > 0 && log.Topics[0] == TransferBatchEventID {
+		batch, err := d.erc1155.UnpackTransferBatchEvent(log)
+		if err != nil {
+			return nil, err
+		}
+		if len(batch.Ids) != len(batch.Values) {
+			return nil, fmt.Errorf("TransferBatch with %d ids and %d values", len(batch.Ids), len(batch.Values))
+		}
+		transfers := make([]*Transfer, len(batch.Ids))
+		for idx := range batch.Ids {
+			transfers[idx] = &Transfer{
+				Operator: batch.Operator,
+				From:     batch.From,
+				To:       batch.To,
+				ID:       batch.Ids[idx],
+				Value:    batch.Values[idx],
+				Raw:      log,
+			}
+		}
+		return transfers, nil
+	}
+
+	single, err := d.erc1155.UnpackTransferSingleEvent(log)
+	if err != nil {
+		return nil, err
+	}
+	return []*Transfer{{
+		Operator: single.Operator,
+		From:     single.From,
+		To:       single.To,
+		ID:       single.Id,
+		Value:    single.Value,
+		Raw:      log,
+	}}, nil
+}
+
+// FilterTransfers returns the transfers from any of the from addresses to any of the to addresses
+// emitted between fromBlock and toBlock, the latest block when nil. Empty address lists match any address.
+// TransferBatch events are expanded into one transfer per id.
+func (d *ERC1155Interactions) FilterTransfers(
+	from, to []common.Address,
+	fromBlock uint64,
+	toBlock *uint64,
+) ([]*Transfer, error) {
+	return d.FilterTransfersCtx(d.Ctx, from, to, fromBlock, toBlock)
+}
+
+// FilterTransfersCtx returns the transfers matching the filters using ctx.
+func (d *ERC1155Interactions) FilterTransfersCtx(
+	ctx context.Context,
+	from, to []common.Address,
+	fromBlock uint64,
+	toBlock *uint64,
+) ([]*Transfer, error) {
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		Addresses: []common.Address{d.erc1155Address},
+		Topics: [][]common.Hash{
+			{TransferSingleEventID, TransferBatchEventID},
+			nil,
+			transaction.AddressTopics(from),
+			transaction.AddressTopics(to),
+		},
+	}
+	if toBlock != nil {
+		query.ToBlock = new(big.Int).SetUint64(*toBlock)
+	}
+	logs, err := transaction.FilterLogs(ctx, d.Client, query)
+	if err != nil {
+		return nil, d.callError("FilterTransfers()", err)
+	}
+
+	var transfers []*Transfer
+	for idx := range logs {
+		decoded, err := d.DecodeTransfers(&logs[idx])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode log %d of transaction %s: %w",
+				logs[idx].Index, logs[idx].TxHash.Hex(), err)
+		}
+		transfers = append(transfers, decoded...)
+	}
+	return transfers, nil
+}
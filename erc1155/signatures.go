@@ -0,0 +1,49 @@
+// Package erc1155 provides base functionality for interacting with multi-tokens using the IERC1155 standard.
+package erc1155
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// BaseERC1155Signature represents function signatures for basic ERC1155 operations
+type BaseERC1155Signature string
+
+const (
+	// BalanceOf represents the balanceOf function signature
+	BalanceOf BaseERC1155Signature = "balanceOf(address,uint256)"
+	// BalanceOfBatch represents the balanceOfBatch function signature
+	BalanceOfBatch BaseERC1155Signature = "balanceOfBatch(address[],uint256[])"
+	// SetApprovalForAll represents the setApprovalForAll function signature
+	SetApprovalForAll BaseERC1155Signature = "setApprovalForAll(address,bool)"
+	// IsApprovedForAll represents the isApprovedForAll function signature
+	IsApprovedForAll BaseERC1155Signature = "isApprovedForAll(address,address)"
+	// SafeTransferFrom represents the safeTransferFrom function signature
+	SafeTransferFrom BaseERC1155Signature = "safeTransferFrom(address,address,uint256,uint256,bytes)"
+	// SafeBatchTransferFrom represents the safeBatchTransferFrom function signature
+	SafeBatchTransferFrom BaseERC1155Signature = "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)"
+	// URI represents the uri function signature of the metadata URI extension
+	URI BaseERC1155Signature = "uri(uint256)"
+)
+
+// computeHash returns the Keccak256 hash of the function signature
+func (s BaseERC1155Signature) computeHash() []byte {
+	hash := crypto.NewKeccakState()
+	_, _ = hash.Write([]byte(s)) // hash.Write never returns an error
+	return hash.Sum(nil)
+}
+
+// GetHex returns the hex representation of the function signature
+func (s BaseERC1155Signature) GetHex() string {
+	return hex.EncodeToString(s.computeHash())
+}
+
+func (s BaseERC1155Signature) String() string {
+	return string(s)
+}
+
+// GetSelector returns the Keccak256 hash selector for the base ERC1155 signature
+func (s BaseERC1155Signature) GetSelector() []byte {
+	return s.computeHash()[:4]
+}
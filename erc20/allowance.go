@@ -0,0 +1,222 @@
+package erc20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrAllowanceAdjustmentUnsupported is returned by IncreaseAllowance and DecreaseAllowance for tokens
+// without the increaseAllowance and decreaseAllowance functions, EnsureAllowance being the alternative.
+var ErrAllowanceAdjustmentUnsupported = errors.New("increaseAllowance and decreaseAllowance not supported")
+
+// MaxAllowance is the unlimited allowance set by ApproveMax, the maximum uint256 which tokens usually
+// do not decrease on transfers.
+var MaxAllowance = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), hex.Uint256BitSize), big.NewInt(1))
+
+// AllowanceOptions configures EnsureAllowance.
+type AllowanceOptions struct {
+	// ZeroFirst resets a non-zero allowance to zero, waiting for the reset to be mined, before approving
+	// the new one, as required by tokens such as USDT which refuse to change a non-zero allowance.
+	ZeroFirst bool
+}
+
+// EnsureAllowance approves spender for needed tokens unless its allowance already covers them, in which
+// case no transaction is sent and the returned transaction is nil.
+func (d *Interactions) EnsureAllowance(
+	spender common.Address,
+	needed *big.Int,
+	opts AllowanceOptions,
+) (*types.Transaction, error) {
+	return d.EnsureAllowanceCtx(d.Ctx, spender, needed, opts)
+}
+
+// EnsureAllowanceCtx approves spender for needed tokens like EnsureAllowance using ctx.
+func (d *Interactions) EnsureAllowanceCtx(
+	ctx context.Context,
+	spender common.Address,
+	needed *big.Int,
+	opts AllowanceOptions,
+) (*types.Transaction, error) {
+	if err := checkAmount(needed); err != nil {
+		return nil, base.WrapCallError("erc20", "EnsureAllowance()", err)
+	}
+	allowance, err := d.AllowanceCtx(ctx, d.Address, spender)
+	if err != nil {
+		return nil, err
+	}
+	if allowance.Cmp(needed) >= 0 {
+		return nil, nil
+	}
+
+	if opts.ZeroFirst && allowance.Sign() != 0 {
+		tx, err := d.ApproveCtx(ctx, spender, new(big.Int))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := d.WaitReceipt(ctx, tx, d.Confirmations()); err != nil {
+			return nil, err
+		}
+	}
+	return d.ApproveCtx(ctx, spender, needed)
+}
+
+// ApproveMax approves spender for an unlimited amount of tokens, MaxAllowance.
+func (d *Interactions) ApproveMax(spender common.Address) (*types.Transaction, error) {
+	return d.ApproveMaxCtx(d.Ctx, spender)
+}
+
+// ApproveMaxCtx approves spender for an unlimited amount of tokens using ctx.
+func (d *Interactions) ApproveMaxCtx(ctx context.Context, spender common.Address) (*types.Transaction, error) {
+	return d.ApproveCtx(ctx, spender, MaxAllowance)
+}
+
+// IncreaseAllowance adds added to the allowance of spender through the token's increaseAllowance,
+// which unlike Approve does not race with the spender spending the current allowance.
+func (d *Interactions) IncreaseAllowance(spender common.Address, added *big.Int) (*types.Transaction, error) {
+	return d.IncreaseAllowanceCtx(d.Ctx, spender, added)
+}
+
+// IncreaseAllowanceCtx adds added to the allowance of spender using ctx.
+func (d *Interactions) IncreaseAllowanceCtx(
+	ctx context.Context,
+	spender common.Address,
+	added *big.Int,
+) (*types.Transaction, error) {
+	if err := checkAmount(added); err != nil {
+		return nil, base.WrapCallError("erc20", "IncreaseAllowance()", err)
+	}
+	return d.adjustAllowance(ctx, IncreaseAllowance, d.extension.PackIncreaseAllowance(spender, added))
+}
+
+// DecreaseAllowance subtracts subtracted from the allowance of spender through the token's
+// decreaseAllowance, which fails rather than underflows when the allowance is lower.
+func (d *Interactions) DecreaseAllowance(spender common.Address, subtracted *big.Int) (*types.Transaction, error) {
+	return d.DecreaseAllowanceCtx(d.Ctx, spender, subtracted)
+}
+
+// DecreaseAllowanceCtx subtracts subtracted from the allowance of spender using ctx.
+func (d *Interactions) DecreaseAllowanceCtx(
+	ctx context.Context,
+	spender common.Address,
+	subtracted *big.Int,
+) (*types.Transaction, error) {
+	if err := checkAmount(subtracted); err != nil {
+		return nil, base.WrapCallError("erc20", "DecreaseAllowance()", err)
+	}
+	return d.adjustAllowance(ctx, DecreaseAllowance, d.extension.PackDecreaseAllowance(spender, subtracted))
+}
+
+// adjustAllowance sends the increaseAllowance or decreaseAllowance call after checking the token
+// implements the function.
+func (d *Interactions) adjustAllowance(
+	ctx context.Context,
+	function BaseERC20Signature,
+	calldata []byte,
+) (*types.Transaction, error) {
+	capabilities, err := d.DetectCapabilities(ctx, d.erc20Address, []hex.Signature{function})
+	if err != nil {
+		return nil, err
+	}
+	if !capabilities.Supports(function.String()) {
+		return nil, fmt.Errorf("%w by %s", ErrAllowanceAdjustmentUnsupported, d.erc20Address.Hex())
+	}
+
+	tx, err := transaction.TransactCtx(ctx, d, d.session, calldata, transaction.DefaultUnpacker)
+	if err != nil {
+		if function == IncreaseAllowance {
+			return nil, d.extensionError("IncreaseAllowance()", err)
+		}
+		return nil, d.extensionError("DecreaseAllowance()", err)
+	}
+	return tx, nil
+}
+
+// RevokeAll sets the allowance of every spender to zero. Allowances are read first, in a single
+// multicall when enabled, and only spenders with a non-zero allowance get a revocation, the
+// transactions being sent back to back without waiting for them to be mined. On failure the
+// transactions already sent are returned along with the error.
+func (d *Interactions) RevokeAll(spenders []common.Address) ([]*types.Transaction, error) {
+	return d.RevokeAllCtx(d.Ctx, spenders)
+}
+
+// RevokeAllCtx sets the allowance of every spender to zero like RevokeAll using ctx.
+func (d *Interactions) RevokeAllCtx(ctx context.Context, spenders []common.Address) ([]*types.Transaction, error) {
+	allowances, err := d.allowancesCtx(ctx, spenders)
+	if err != nil {
+		return nil, err
+	}
+
+	var txs []*types.Transaction
+	for idx, spender := range spenders {
+		if allowances[idx].Sign() == 0 {
+			continue
+		}
+		tx, err := d.ApproveCtx(ctx, spender, new(big.Int))
+		if err != nil {
+			return txs, fmt.Errorf("failed to revoke %s: %w", spender.Hex(), err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// allowancesCtx returns the allowances of the interactions' address to the spenders.
+func (d *Interactions) allowancesCtx(ctx context.Context, spenders []common.Address) ([]*big.Int, error) {
+	allowances := make([]*big.Int, len(spenders))
+	if multicall := d.NewMulticall(d.callOpts); multicall != nil {
+		results := make([]*transaction.Result[*big.Int], len(spenders))
+		for idx, spender := range spenders {
+			results[idx] = transaction.AddCall(
+				multicall, d.erc20Address, d.erc20.PackAllowance(d.Address, spender), d.erc20.UnpackAllowance, false,
+			)
+		}
+		if err := multicall.Execute(ctx); err != nil {
+			return nil, d.callError("Allowance()", err)
+		}
+		for idx, result := range results {
+			allowance, err := result.Get()
+			if err != nil {
+				return nil, d.callError("Allowance()", err)
+			}
+			allowances[idx] = allowance
+		}
+		return allowances, nil
+	}
+
+	errs := make([]error, len(spenders))
+	reads := make([]func(), len(spenders))
+	for idx, spender := range spenders {
+		reads[idx] = func() { allowances[idx], errs[idx] = d.AllowanceCtx(ctx, d.Address, spender) }
+	}
+	d.RunReads(reads...)
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return allowances, nil
+}
+
+// parseAllowanceError parses the errors of the increaseAllowance and decreaseAllowance functions.
+func parseAllowanceError(rawErr any) error {
+	switch e := rawErr.(type) {
+	case *inferences.Ierc20allowanceERC20FailedDecreaseAllowance:
+		return fmt.Errorf(
+			"ERC20FailedDecreaseAllowance: %s, allowance %s, requested decrease: %s",
+			e.Spender.Hex(),
+			e.CurrentAllowance.String(),
+			e.RequestedDecrease.String(),
+		)
+	case *inferences.Ierc20allowanceERC20InvalidSpender:
+		return fmt.Errorf("ERC20InvalidSpender: %s", e.Spender.Hex())
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,307 @@
+package erc20_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/erc20"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupAllowanceToken deploys a token implementing increaseAllowance and decreaseAllowance, refusing
+// to change non-zero allowances when requireZeroFirst is set, and returns interactions with it mined
+// in the background.
+func setupAllowanceToken(
+	t *testing.T,
+	requireZeroFirst bool,
+) (*simulated.Backend, *bind.TransactOpts, *erc20.Interactions, func()) {
+	backend, auth, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20allowanceMetaData.ABI,
+		inferences.Ierc20allowanceMetaData.Bin,
+		requireZeroFirst,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	baseInteractions.SetPollInterval(10 * time.Millisecond)
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{
+		erc20.Approve, erc20.IncreaseAllowance, erc20.DecreaseAllowance,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := testingtools.AutoCommit(backend, 20*time.Millisecond)
+	return backend, auth, token, func() {
+		stop()
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}
+}
+
+// mined waits for tx to be mined successfully.
+func mined(t *testing.T, token *erc20.Interactions, tx *types.Transaction) {
+	t.Helper()
+	receipt, err := token.WaitReceipt(context.Background(), tx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+}
+
+// Test_EnsureAllowance verifies that approvals are only sent when the allowance is insufficient, and
+// reset to zero first when requested.
+func Test_EnsureAllowance(t *testing.T) {
+	spender := common.HexToAddress("0x5001")
+	testCases := []struct {
+		Name             string
+		RequireZeroFirst bool
+		Current          *big.Int
+		Needed           *big.Int
+		Opts             erc20.AllowanceOptions
+		ExpectTx         bool
+		ExpectError      bool
+		ExpectedError    string
+	}{
+		{
+			Name:    "OK - Sufficient allowance",
+			Current: big.NewInt(100),
+			Needed:  big.NewInt(100),
+		},
+		{
+			Name:     "OK - Insufficient allowance",
+			Current:  big.NewInt(10),
+			Needed:   big.NewInt(100),
+			ExpectTx: true,
+		},
+		{
+			Name:             "OK - Zero-first token from zero",
+			RequireZeroFirst: true,
+			Current:          big.NewInt(0),
+			Needed:           big.NewInt(100),
+			ExpectTx:         true,
+		},
+		{
+			Name:             "OK - Zero-first token reset",
+			RequireZeroFirst: true,
+			Current:          big.NewInt(10),
+			Needed:           big.NewInt(100),
+			Opts:             erc20.AllowanceOptions{ZeroFirst: true},
+			ExpectTx:         true,
+		},
+		{
+			Name:          "NOK - Nil needed",
+			Current:       big.NewInt(0),
+			ExpectError:   true,
+			ExpectedError: "erc20.EnsureAllowance(): amount cannot be nil",
+		},
+		{
+			Name:          "NOK - Negative needed",
+			Current:       big.NewInt(0),
+			Needed:        big.NewInt(-1),
+			ExpectError:   true,
+			ExpectedError: "erc20.EnsureAllowance(): amount cannot be negative",
+		},
+		{
+			Name:             "NOK - Zero-first token without reset",
+			RequireZeroFirst: true,
+			Current:          big.NewInt(10),
+			Needed:           big.NewInt(100),
+			ExpectError:      true,
+			ExpectedError:    "execution reverted",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, _, token, teardown := setupAllowanceToken(t, tt.RequireZeroFirst)
+			defer teardown()
+
+			if tt.Current.Sign() > 0 {
+				tx, err := token.Approve(spender, tt.Current)
+				if err != nil {
+					t.Fatal(err)
+				}
+				mined(t, token, tx)
+			}
+
+			tx, err := token.EnsureAllowance(spender, tt.Needed, tt.Opts)
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+				return
+			}
+			if !assert.Nil(t, err) {
+				return
+			}
+			if !tt.ExpectTx {
+				assert.Nil(t, tx)
+				return
+			}
+			mined(t, token, tx)
+			allowance, err := token.Allowance(token.Address, spender)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.Needed, allowance)
+		})
+	}
+}
+
+// Test_AdjustAllowance verifies increaseAllowance and decreaseAllowance, and that tokens without them
+// are detected before sending anything.
+func Test_AdjustAllowance(t *testing.T) {
+	_, _, token, teardown := setupAllowanceToken(t, false)
+	defer teardown()
+
+	spender := common.HexToAddress("0x5001")
+	tx, err := token.IncreaseAllowance(spender, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mined(t, token, tx)
+	tx, err = token.IncreaseAllowance(spender, big.NewInt(50))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mined(t, token, tx)
+	tx, err = token.DecreaseAllowance(spender, big.NewInt(30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mined(t, token, tx)
+
+	allowance, err := token.Allowance(token.Address, spender)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(120), allowance.Int64())
+
+	_, err = token.DecreaseAllowance(spender, big.NewInt(121))
+	assert.ErrorContains(
+		t, err, "erc20.DecreaseAllowance(): ERC20FailedDecreaseAllowance: "+spender.Hex()+", allowance 120",
+	)
+	_, err = token.IncreaseAllowance(common.Address{}, big.NewInt(1))
+	assert.ErrorContains(t, err, "erc20.IncreaseAllowance(): ERC20InvalidSpender")
+	_, err = token.IncreaseAllowance(spender, nil)
+	assert.ErrorIs(t, err, erc20.ErrNilAmount)
+	_, err = token.IncreaseAllowance(spender, big.NewInt(-1))
+	assert.ErrorIs(t, err, erc20.ErrNegativeAmount)
+	_, err = token.DecreaseAllowance(spender, nil)
+	assert.ErrorIs(t, err, erc20.ErrNilAmount)
+	_, err = token.DecreaseAllowance(spender, big.NewInt(-1))
+	assert.ErrorIs(t, err, erc20.ErrNegativeAmount)
+
+	// The plain ERC20 token has no allowance adjustment functions.
+	backend, _, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+	plain, err := erc20.NewIERC20Interactions(
+		base.NewBaseInteractions(backend.Client(), privKey, nil, false),
+		*contractAddress,
+		[]erc20.BaseERC20Signature{erc20.Approve},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = plain.IncreaseAllowance(spender, big.NewInt(1))
+	assert.True(t, errors.Is(err, erc20.ErrAllowanceAdjustmentUnsupported), "unexpected error: %v", err)
+	_, err = plain.DecreaseAllowance(spender, big.NewInt(1))
+	assert.True(t, errors.Is(err, erc20.ErrAllowanceAdjustmentUnsupported), "unexpected error: %v", err)
+}
+
+// Test_ApproveMax verifies that the unlimited approval is the maximum uint256.
+func Test_ApproveMax(t *testing.T) {
+	_, _, token, teardown := setupAllowanceToken(t, false)
+	defer teardown()
+
+	spender := common.HexToAddress("0x5001")
+	tx, err := token.ApproveMax(spender)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mined(t, token, tx)
+
+	allowance, err := token.Allowance(token.Address, spender)
+	assert.Nil(t, err)
+	assert.Equal(t, erc20.MaxAllowance, allowance)
+	assert.Equal(t, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), allowance)
+}
+
+// Test_RevokeAll verifies that only spenders with an allowance get a revocation, with and without
+// multicall batching of the allowance reads.
+func Test_RevokeAll(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Multicall bool
+	}{
+		{Name: "OK - Individual reads"},
+		{Name: "OK - Multicall reads", Multicall: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			backend, auth, token, teardown := setupAllowanceToken(t, true)
+			defer teardown()
+
+			if tt.Multicall {
+				multicallAddress, err := testingtools.DeployMulticall3(auth, backend)
+				if err != nil {
+					t.Fatal(err)
+				}
+				token.SetMulticall(*multicallAddress, 0)
+			}
+
+			spenders := []common.Address{
+				common.HexToAddress("0x5001"),
+				common.HexToAddress("0x5002"),
+				common.HexToAddress("0x5003"),
+			}
+			for _, spender := range []common.Address{spenders[0], spenders[2]} {
+				tx, err := token.Approve(spender, big.NewInt(10))
+				if err != nil {
+					t.Fatal(err)
+				}
+				mined(t, token, tx)
+			}
+
+			txs, err := token.RevokeAll(spenders)
+			if !assert.Nil(t, err) {
+				return
+			}
+			assert.Len(t, txs, 2)
+			for _, tx := range txs {
+				mined(t, token, tx)
+			}
+			for _, spender := range spenders {
+				allowance, err := token.Allowance(token.Address, spender)
+				assert.Nil(t, err)
+				assert.Zero(t, allowance.Sign())
+			}
+
+			txs, err = token.RevokeAll(spenders)
+			assert.Nil(t, err)
+			assert.Empty(t, txs)
+		})
+	}
+}
@@ -2,6 +2,8 @@
 package erc20
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -40,6 +42,9 @@ type Interactions struct {
 	*session
 	erc20Address common.Address
 	callError    func(string, error) error
+	// extension packs the increaseAllowance and decreaseAllowance functions some tokens implement.
+	extension      *inferences.Ierc20allowance
+	extensionError func(string, error) error
 }
 
 // NewIERC20Interactions creates a new instance of IERC20AInteractions from a base interaction
@@ -65,16 +70,20 @@ func NewIERC20Interactions(
 	ierc20Session := &session{
 		erc20:    ierc20,
 		callOpts: &bind.CallOpts{Pending: true, From: baseInteractions.Address},
-		instance: ierc20.Instance(baseInteractions.Client, address),
+		instance: ierc20.Instance(baseInteractions.Backend(), address),
 	}
 
 	callError := base.GenCallError("erc20", ParseError, ierc20.UnpackError)
+	extension := inferences.NewIerc20allowance()
+	extensionError := base.GenCallError("erc20", parseAllowanceError, extension.UnpackError)
 
 	ierc20Asession := &Interactions{
 		baseInteractions,
 		ierc20Session,
 		address,
 		callError,
+		extension,
+		extensionError,
 	}
 
 	if len(transactOps) > 0 {
@@ -97,9 +106,56 @@ func (d *Interactions) GetSession() transaction.Session {
 	return d.session
 }
 
+// AtBlock returns a read-only copy of the interactions whose calls are pinned to the given block.
+// A nil block number stands for the latest block, as in go-ethereum, and pins nothing.
+func (d *Interactions) AtBlock(blockNumber *big.Int) *Interactions {
+	callOpts := &bind.CallOpts{From: d.Address}
+	if blockNumber != nil {
+		callOpts.BlockNumber = new(big.Int).Set(blockNumber)
+	}
+	return d.withCallOpts(callOpts, true)
+}
+
+// AtBlockHash returns a read-only copy of the interactions whose calls are pinned to the given block hash.
+func (d *Interactions) AtBlockHash(blockHash common.Hash) *Interactions {
+	return d.withCallOpts(&bind.CallOpts{From: d.Address, BlockHash: blockHash}, true)
+}
+
+// Latest returns a copy of the interactions whose calls run against the latest block.
+// Copies of read-only views stay read-only.
+func (d *Interactions) Latest() *Interactions {
+	return d.withCallOpts(&bind.CallOpts{From: d.Address}, false)
+}
+
+// Pending returns a copy of the interactions whose calls run against the pending state, the default.
+// Copies of read-only views stay read-only.
+func (d *Interactions) Pending() *Interactions {
+	return d.withCallOpts(&bind.CallOpts{Pending: true, From: d.Address}, false)
+}
+
+// withCallOpts returns a copy of the interactions calling with callOpts, read-only if requested.
+func (d *Interactions) withCallOpts(callOpts *bind.CallOpts, readOnly bool) *Interactions {
+	view := *d
+	view.session = &session{
+		erc20:    d.erc20,
+		callOpts: callOpts,
+		instance: d.instance,
+	}
+	if readOnly {
+		view.Interactions = d.Interactions.ReadOnly()
+	}
+	return &view
+}
+
 // GetBalance retrieves the balance of NFTs for the associated address.
 func (d *Interactions) GetBalance() (*big.Int, error) {
-	balance, err := transaction.Call(
+	return d.GetBalanceCtx(d.Ctx)
+}
+
+// GetBalanceCtx retrieves the balance of the associated address using ctx.
+func (d *Interactions) GetBalanceCtx(ctx context.Context) (*big.Int, error) {
+	balance, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc20.PackBalanceOf(d.Address),
 		d.erc20.UnpackBalanceOf,
@@ -112,7 +168,17 @@ func (d *Interactions) GetBalance() (*big.Int, error) {
 
 // TransferTo transfers a specific token to another address after verifying ownership.
 func (d *Interactions) TransferTo(to common.Address, amount *big.Int) (*types.Transaction, error) {
-	tx, err := transaction.Transact(
+	return d.TransferToCtx(d.Ctx, to, amount)
+}
+
+// TransferToCtx transfers tokens to another address using ctx.
+func (d *Interactions) TransferToCtx(
+	ctx context.Context,
+	to common.Address,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
 		d,
 		d.session,
 		d.erc20.PackTransfer(to, amount),
@@ -124,9 +190,92 @@ func (d *Interactions) TransferTo(to common.Address, amount *big.Int) (*types.Tr
 	return tx, nil
 }
 
+var (
+	// ErrNilAmount is returned when transferring or approving a nil amount of tokens.
+	ErrNilAmount = errors.New("amount cannot be nil")
+	// ErrNegativeAmount is returned when transferring or approving a negative amount of tokens, which would
+	// be packed as a huge uint256.
+	ErrNegativeAmount = errors.New("amount cannot be negative")
+	// ErrZeroAmount is returned when dispersing a zero amount to a recipient, most likely a mistake in the
+	// amounts list.
+	ErrZeroAmount = errors.New("amount cannot be zero")
+)
+
+// checkAmount returns ErrNilAmount or ErrNegativeAmount for amounts that cannot be sent.
+func checkAmount(amount *big.Int) error {
+	if amount == nil {
+		return ErrNilAmount
+	}
+	if amount.Sign() < 0 {
+		return ErrNegativeAmount
+	}
+	return nil
+}
+
+// TransferFrom transfers amount tokens from an address which approved the associated address to another
+// address. The allowance and balance of from are checked first so that an *InsufficientAllowanceError or
+// *InsufficientBalanceError is returned, as the token would revert with, without sending a transaction bound
+// to fail.
+func (d *Interactions) TransferFrom(from, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return d.TransferFromCtx(d.Ctx, from, to, amount)
+}
+
+// TransferFromCtx transfers tokens on behalf of from like TransferFrom using ctx.
+func (d *Interactions) TransferFromCtx(
+	ctx context.Context,
+	from, to common.Address,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	if err := checkAmount(amount); err != nil {
+		return nil, base.WrapCallError("erc20", "TransferFrom()", err)
+	}
+
+	var allowance, balance *big.Int
+	var allowanceErr, balanceErr error
+	d.RunReads(
+		func() { allowance, allowanceErr = d.AllowanceCtx(ctx, from, d.Address) },
+		func() { balance, balanceErr = d.BalanceOfCtx(ctx, from) },
+	)
+	if allowanceErr != nil {
+		return nil, allowanceErr
+	}
+	if balanceErr != nil {
+		return nil, balanceErr
+	}
+	// Checked in the order of OpenZeppelin's transferFrom, which spends the allowance first.
+	if allowance.Cmp(amount) < 0 {
+		return nil, base.WrapCallError("erc20", "TransferFrom()", &InsufficientAllowanceError{
+			Spender: d.Address, Allowance: allowance, Needed: amount,
+		})
+	}
+	if balance.Cmp(amount) < 0 {
+		return nil, base.WrapCallError("erc20", "TransferFrom()", &InsufficientBalanceError{
+			Sender: from, Balance: balance, Needed: amount,
+		})
+	}
+
+	tx, err := transaction.TransactCtx(
+		ctx,
+		d,
+		d.session,
+		d.erc20.PackTransferFrom(from, to, amount),
+		transaction.DefaultUnpacker,
+	)
+	if err != nil {
+		return nil, d.callError("TransferFrom()", err)
+	}
+	return tx, nil
+}
+
 // Decimals returns the number of decimals used to get its user representation.
 func (d *Interactions) Decimals() (uint8, error) {
-	decimals, err := transaction.Call(
+	return d.DecimalsCtx(d.Ctx)
+}
+
+// DecimalsCtx returns the number of decimals of the token using ctx.
+func (d *Interactions) DecimalsCtx(ctx context.Context) (uint8, error) {
+	decimals, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc20.PackDecimals(),
 		d.erc20.UnpackDecimals,
@@ -140,7 +289,13 @@ func (d *Interactions) Decimals() (uint8, error) {
 
 // TotalSupply returns the total number of NFTs minted.
 func (d *Interactions) TotalSupply() (*big.Int, error) {
-	supply, err := transaction.Call(
+	return d.TotalSupplyCtx(d.Ctx)
+}
+
+// TotalSupplyCtx returns the total supply of the token using ctx.
+func (d *Interactions) TotalSupplyCtx(ctx context.Context) (*big.Int, error) {
+	supply, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc20.PackTotalSupply(),
 		d.erc20.UnpackTotalSupply,
@@ -154,7 +309,13 @@ func (d *Interactions) TotalSupply() (*big.Int, error) {
 
 // BalanceOf retrieves the NFT balance for a given owner.
 func (d *Interactions) BalanceOf(owner common.Address) (*big.Int, error) {
-	balance, err := transaction.Call(
+	return d.BalanceOfCtx(d.Ctx, owner)
+}
+
+// BalanceOfCtx retrieves the token balance of owner using ctx.
+func (d *Interactions) BalanceOfCtx(ctx context.Context, owner common.Address) (*big.Int, error) {
+	balance, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc20.PackBalanceOf(owner),
 		d.erc20.UnpackBalanceOf,
@@ -167,7 +328,17 @@ func (d *Interactions) BalanceOf(owner common.Address) (*big.Int, error) {
 
 // Approve approves an address to transfer a specific token.
 func (d *Interactions) Approve(to common.Address, allowance *big.Int) (*types.Transaction, error) {
-	tx, err := transaction.Transact(
+	return d.ApproveCtx(d.Ctx, to, allowance)
+}
+
+// ApproveCtx approves an address to spend allowance tokens using ctx.
+func (d *Interactions) ApproveCtx(
+	ctx context.Context,
+	to common.Address,
+	allowance *big.Int,
+) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
 		d,
 		d.session,
 		d.erc20.PackApprove(to, allowance),
@@ -181,20 +352,63 @@ func (d *Interactions) Approve(to common.Address, allowance *big.Int) (*types.Tr
 
 // TokenMetaInfos retrieves metadata about the specified token such as name, symbol, and URI.
 func (d *Interactions) TokenMetaInfos() (*models.TokenMeta, error) {
-	name, err := d.Name()
+	return d.TokenMetaInfosCtx(d.Ctx)
+}
+
+// TokenMetaInfosCtx retrieves the name and symbol of the token using ctx.
+// Both reads are aggregated in a single call when multicall batching is enabled, and share a
+// JSON-RPC batch when RPC batching is.
+func (d *Interactions) TokenMetaInfosCtx(ctx context.Context) (*models.TokenMeta, error) {
+	if multicall := d.NewMulticall(d.callOpts); multicall != nil {
+		return d.batchTokenMetaInfos(ctx, multicall)
+	}
+
+	var name, symbol string
+	var nameErr, symbolErr error
+	d.RunReads(
+		func() { name, nameErr = d.NameCtx(ctx) },
+		func() { symbol, symbolErr = d.SymbolCtx(ctx) },
+	)
+	if nameErr != nil {
+		return nil, nameErr
+	}
+	if symbolErr != nil {
+		return &models.TokenMeta{Name: name}, symbolErr
+	}
+	return &models.TokenMeta{Name: name, Symbol: symbol}, nil
+}
+
+// batchTokenMetaInfos retrieves the name and symbol of the token through multicall.
+func (d *Interactions) batchTokenMetaInfos(
+	ctx context.Context,
+	multicall *transaction.Multicall,
+) (*models.TokenMeta, error) {
+	nameResult := transaction.AddCall(multicall, d.erc20Address, d.erc20.PackName(), d.erc20.UnpackName, true)
+	symbolResult := transaction.AddCall(multicall, d.erc20Address, d.erc20.PackSymbol(), d.erc20.UnpackSymbol, true)
+	if err := multicall.Execute(ctx); err != nil {
+		return nil, d.callError("Name()", err)
+	}
+
+	name, err := nameResult.Get()
 	if err != nil {
-		return nil, err
+		return nil, d.callError("Name()", err)
 	}
-	symbol, err := d.Symbol()
+	symbol, err := symbolResult.Get()
 	if err != nil {
-		return &models.TokenMeta{Name: name}, err
+		return &models.TokenMeta{Name: name}, d.callError("Symbol()", err)
 	}
 	return &models.TokenMeta{Name: name, Symbol: symbol}, nil
 }
 
 // Name returns the name of the NFT.
 func (d *Interactions) Name() (string, error) {
-	name, err := transaction.Call(
+	return d.NameCtx(d.Ctx)
+}
+
+// NameCtx returns the name of the token using ctx.
+func (d *Interactions) NameCtx(ctx context.Context) (string, error) {
+	name, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc20.PackName(),
 		d.erc20.UnpackName,
@@ -209,7 +423,13 @@ func (d *Interactions) Name() (string, error) {
 
 // Symbol returns the symbol of the NFT.
 func (d *Interactions) Symbol() (string, error) {
-	symbol, err := transaction.Call(
+	return d.SymbolCtx(d.Ctx)
+}
+
+// SymbolCtx returns the symbol of the token using ctx.
+func (d *Interactions) SymbolCtx(ctx context.Context) (string, error) {
+	symbol, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc20.PackSymbol(),
 		d.erc20.UnpackSymbol,
@@ -223,7 +443,13 @@ func (d *Interactions) Symbol() (string, error) {
 
 // Allowance returns the amount of tokens that spender is allowed to spend on behalf of owner
 func (d *Interactions) Allowance(owner, spender common.Address) (*big.Int, error) {
-	allowance, err := transaction.Call(
+	return d.AllowanceCtx(d.Ctx, owner, spender)
+}
+
+// AllowanceCtx returns the amount of tokens that spender is allowed to spend on behalf of owner using ctx.
+func (d *Interactions) AllowanceCtx(ctx context.Context, owner, spender common.Address) (*big.Int, error) {
+	allowance, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc20.PackAllowance(owner, spender),
 		d.erc20.UnpackAllowance,
@@ -240,16 +466,11 @@ func (d *Interactions) Allowance(owner, spender common.Address) (*big.Int, error
 func ParseError(rawErr any) error {
 	switch e := rawErr.(type) {
 	case *inferences.Ierc20ERC20InsufficientAllowance:
-		return fmt.Errorf(
-			"ERC20InsufficientAllowance: %s, allowance %s, required: %s",
-			e.Spender.Hex(),
-			e.Allowance.String(),
-			e.Needed.String(),
-		)
+		return &InsufficientAllowanceError{Spender: e.Spender, Allowance: e.Allowance, Needed: e.Needed}
 	case *inferences.Ierc20ERC20InvalidSpender:
 		return fmt.Errorf("ERC20InvalidSpender: %s", e.Spender.Hex())
 	case *inferences.Ierc20ERC20InsufficientBalance:
-		return fmt.Errorf("ERC20InsufficientBalance: %s, required: %s", e.Balance.String(), e.Needed.String())
+		return &InsufficientBalanceError{Sender: e.Sender, Balance: e.Balance, Needed: e.Needed}
 	case *inferences.Ierc20ERC20InvalidSender:
 		return fmt.Errorf("ERC20InvalidSender: %s", e.Sender.Hex())
 	case *inferences.Ierc20ERC20InvalidReceiver:
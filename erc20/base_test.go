@@ -5,6 +5,7 @@ package erc20_test
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -468,6 +469,126 @@ func Test_BalanceOf(t *testing.T) {
 	}
 }
 
+// Test_ContextPropagation verifies that the context-taking variants run reads and writes with the given context.
+func Test_ContextPropagation(t *testing.T) {
+	backend, auth, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	base := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	token, err := erc20.NewIERC20Interactions(base, *contractAddress, []erc20.BaseERC20Signature{erc20.BalanceOf})
+	assert.Nil(t, err)
+
+	balance, err := token.BalanceOfCtx(context.Background(), auth.From)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, balance.Cmp(big.NewInt(0).Mul(big.NewInt(100_000_000), big.NewInt(1e18))))
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = token.BalanceOfCtx(canceled, auth.From)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = token.TransferToCtx(canceled, common.HexToAddress("0x1234"), big.NewInt(1))
+	assert.ErrorIs(t, err, context.Canceled)
+
+	tx, err := token.TransferToCtx(context.Background(), common.HexToAddress("0x1234"), big.NewInt(1))
+	assert.Nil(t, err)
+	backend.Commit()
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), receipt.Status)
+}
+
+// Test_AtBlock verifies that block-pinned views read historical state and refuse transactions.
+func Test_AtBlock(t *testing.T) {
+	backend, auth, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	token, err := erc20.NewIERC20Interactions(baseInteractions,
+		*contractAddress,
+		[]erc20.BaseERC20Signature{erc20.BalanceOf},
+	)
+	assert.Nil(t, err)
+
+	snapshot, err := backend.Client().HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	initial, err := token.BalanceOf(auth.From)
+	assert.Nil(t, err)
+
+	amount := big.NewInt(1e18)
+	_, err = token.TransferTo(common.HexToAddress("0x1234"), amount)
+	assert.Nil(t, err)
+	backend.Commit()
+
+	testCases := []struct {
+		Name           string
+		View           *erc20.Interactions
+		ExpectedResult *big.Int
+	}{
+		{
+			Name:           "OK - Pinned to block number",
+			View:           token.AtBlock(snapshot.Number),
+			ExpectedResult: initial,
+		},
+		{
+			Name:           "OK - Pinned to block hash",
+			View:           token.AtBlockHash(snapshot.Hash()),
+			ExpectedResult: initial,
+		},
+		{
+			Name:           "OK - Latest block",
+			View:           token.AtBlock(snapshot.Number).Latest(),
+			ExpectedResult: new(big.Int).Sub(initial, amount),
+		},
+		{
+			Name:           "OK - Nil block number",
+			View:           token.AtBlock(nil),
+			ExpectedResult: new(big.Int).Sub(initial, amount),
+		},
+		{
+			Name:           "OK - Pending state",
+			View:           token.Pending(),
+			ExpectedResult: new(big.Int).Sub(initial, amount),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			balance, err := tt.View.BalanceOf(auth.From)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, tt.ExpectedResult.Cmp(balance))
+		})
+	}
+
+	_, err = token.AtBlock(snapshot.Number).TransferTo(common.HexToAddress("0x1234"), amount)
+	assert.ErrorIs(t, err, base.ErrReadOnly)
+	_, err = token.AtBlock(nil).TransferTo(common.HexToAddress("0x1234"), amount)
+	assert.ErrorIs(t, err, base.ErrReadOnly)
+}
+
 // Test_Approve tests the approval functionality for token transfers.
 func Test_Approve(t *testing.T) {
 	backend, _, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
@@ -539,9 +660,149 @@ func Test_Approve(t *testing.T) {
 	}
 }
 
+// Test_TransferFrom verifies that spenders pull approved tokens, and that insufficient allowances and
+// balances are reported before sending anything.
+func Test_TransferFrom(t *testing.T) {
+	backend, _, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	spenderKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownerInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	_, err = ownerInteractions.TransferETH(crypto.PubkeyToAddress(spenderKey.PublicKey), big.NewInt(1e18))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	signatures := []erc20.BaseERC20Signature{erc20.Approve, erc20.TransferFrom}
+	owner, err := erc20.NewIERC20Interactions(ownerInteractions, *contractAddress, signatures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spender, err := erc20.NewIERC20Interactions(
+		base.NewBaseInteractions(backend.Client(), spenderKey, nil, false), *contractAddress, signatures,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	balance, err := owner.GetBalance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipient := common.HexToAddress("0x7001")
+
+	testCases := []struct {
+		Name          string
+		Allowance     *big.Int
+		Amount        *big.Int
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:          "NOK - Insufficient balance",
+			Allowance:     hex.MaxUint256,
+			Amount:        new(big.Int).Add(balance, big.NewInt(1)),
+			ExpectError:   true,
+			ExpectedError: "erc20.TransferFrom(): ERC20InsufficientBalance: " + balance.String(),
+		},
+		{
+			Name:      "OK - Within allowance",
+			Allowance: big.NewInt(100),
+			Amount:    big.NewInt(60),
+		},
+		{
+			Name:          "NOK - Insufficient allowance",
+			Allowance:     big.NewInt(100),
+			Amount:        big.NewInt(101),
+			ExpectError:   true,
+			ExpectedError: "erc20.TransferFrom(): ERC20InsufficientAllowance: " + spender.Address.Hex(),
+		},
+		{
+			Name:          "NOK - Nil amount",
+			Allowance:     big.NewInt(100),
+			ExpectError:   true,
+			ExpectedError: "erc20.TransferFrom(): amount cannot be nil",
+		},
+		{
+			Name:          "NOK - Negative amount",
+			Allowance:     big.NewInt(100),
+			Amount:        big.NewInt(-1),
+			ExpectError:   true,
+			ExpectedError: "erc20.TransferFrom(): amount cannot be negative",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if _, err := owner.Approve(spender.Address, tt.Allowance); err != nil {
+				t.Fatal(err)
+			}
+			backend.Commit()
+			received, err := owner.BalanceOf(recipient)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = spender.TransferFrom(owner.Address, recipient, tt.Amount)
+			backend.Commit()
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+				var allowanceErr *erc20.InsufficientAllowanceError
+				var balanceErr *erc20.InsufficientBalanceError
+				switch {
+				case errors.As(err, &allowanceErr):
+					assert.Equal(t, tt.Allowance, allowanceErr.Allowance)
+					assert.Equal(t, tt.Amount, allowanceErr.Needed)
+				case errors.As(err, &balanceErr):
+					assert.Equal(t, owner.Address, balanceErr.Sender)
+					assert.Equal(t, balance, balanceErr.Balance)
+					assert.Equal(t, tt.Amount, balanceErr.Needed)
+				default:
+					assert.True(t, errors.Is(err, erc20.ErrNilAmount) || errors.Is(err, erc20.ErrNegativeAmount))
+				}
+				return
+			}
+			if !assert.Nil(t, err) {
+				return
+			}
+			after, err := owner.BalanceOf(recipient)
+			assert.Nil(t, err)
+			assert.Equal(t, new(big.Int).Add(received, tt.Amount), after)
+			remaining, err := owner.Allowance(owner.Address, spender.Address)
+			assert.Nil(t, err)
+			assert.Equal(t, new(big.Int).Sub(tt.Allowance, tt.Amount), remaining)
+		})
+	}
+
+	// Reverts decode into the same errors as the checks run before sending.
+	_, err = spender.TransferTo(recipient, big.NewInt(1))
+	var balanceErr *erc20.InsufficientBalanceError
+	if assert.ErrorAs(t, err, &balanceErr) {
+		assert.Equal(t, spender.Address, balanceErr.Sender)
+		assert.Zero(t, balanceErr.Balance.Sign())
+	}
+}
+
 // Test_TokenMetaInfos verifies that the metadata (name, symbol, and URI) for a token is correctly retrieved.
 func Test_TokenMetaInfos(t *testing.T) {
-	backend, _, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+	backend, auth, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
 		inferences.Ierc20MetaData.ABI,
 		inferences.Ierc20MetaData.Bin,
 	)
@@ -563,4 +824,16 @@ func Test_TokenMetaInfos(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "TESTToken", tokenInfo.Name)
 	assert.Equal(t, "TT", tokenInfo.Symbol)
+
+	// Test meta infos batched through multicall
+	multicallAddress, err := testingtools.DeployMulticall3(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base.SetMulticall(*multicallAddress, 0)
+
+	tokenInfo, err = token.TokenMetaInfos()
+	assert.Nil(t, err)
+	assert.Equal(t, "TESTToken", tokenInfo.Name)
+	assert.Equal(t, "TT", tokenInfo.Symbol)
 }
@@ -2,6 +2,7 @@
 package burnable
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -46,7 +47,13 @@ func NewIERC20Burnable(
 
 // Burn destroys the specified token from the owner's balance.
 func (e *IERC20BurnableInteractions) Burn(qty *big.Int) (*types.Transaction, error) {
-	tx, err := transaction.Transact(
+	return e.BurnCtx(e.Ctx, qty)
+}
+
+// BurnCtx destroys the specified quantity from the owner's balance using ctx.
+func (e *IERC20BurnableInteractions) BurnCtx(ctx context.Context, qty *big.Int) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
 		e,
 		e,
 		e.erc20Burnable.PackBurn(qty),
@@ -61,7 +68,17 @@ func (e *IERC20BurnableInteractions) Burn(qty *big.Int) (*types.Transaction, err
 
 // BurnFrom is a wrapper for Burn that calls the token's burnFrom function instead.
 func (e *IERC20BurnableInteractions) BurnFrom(from common.Address, qty *big.Int) (*types.Transaction, error) {
-	tx, err := transaction.Transact(
+	return e.BurnFromCtx(e.Ctx, from, qty)
+}
+
+// BurnFromCtx calls the token's burnFrom function using ctx.
+func (e *IERC20BurnableInteractions) BurnFromCtx(
+	ctx context.Context,
+	from common.Address,
+	qty *big.Int,
+) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
 		e,
 		e,
 		e.erc20Burnable.PackBurnFrom(from, qty),
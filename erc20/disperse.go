@@ -0,0 +1,178 @@
+package erc20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DisperseVariant selects the Disperse contract function used to distribute tokens.
+type DisperseVariant int
+
+const (
+	// DisperseAuto uses the variant with the lowest gas estimate.
+	DisperseAuto DisperseVariant = iota
+	// DisperseToken pulls the total into the Disperse contract with a single transferFrom,
+	// then transfers each amount from the contract.
+	DisperseToken
+	// DisperseTokenSimple transfers each amount from the sender with transferFrom. Unlike DisperseToken,
+	// it supports tokens charging a fee on transfers.
+	DisperseTokenSimple
+)
+
+var (
+	// ErrNoRecipients is returned when a dispersal has no recipient.
+	ErrNoRecipients = errors.New("no recipient to disperse to")
+	// ErrAmountsLength is returned when the number of amounts differs from the number of recipients.
+	ErrAmountsLength = errors.New("recipients and amounts lengths differ")
+)
+
+// DisperseOptions configures a token dispersal.
+type DisperseOptions struct {
+	// Variant selects the Disperse function used, DisperseAuto by default.
+	Variant DisperseVariant
+	// TopUpAllowance approves the Disperse contract for the dispersed total when its allowance is lower,
+	// waiting for the approval to be mined. Otherwise an *InsufficientAllowanceError is returned.
+	TopUpAllowance bool
+}
+
+// DisperseTokens sends amounts[i] tokens to recipients[i] in a single transaction through the Disperse
+// contract at disperse, and returns the mined receipt. A zero disperse address uses the contract set on
+// the base interactions or registered for the chain.
+func (d *Interactions) DisperseTokens(
+	disperse common.Address,
+	recipients []common.Address,
+	amounts []*big.Int,
+	opts DisperseOptions,
+) (*types.Receipt, error) {
+	return d.DisperseTokensCtx(d.Ctx, disperse, recipients, amounts, opts)
+}
+
+// DisperseTokensCtx sends amounts[i] tokens to recipients[i] through the Disperse contract using ctx.
+func (d *Interactions) DisperseTokensCtx(
+	ctx context.Context,
+	disperse common.Address,
+	recipients []common.Address,
+	amounts []*big.Int,
+	opts DisperseOptions,
+) (*types.Receipt, error) {
+	if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	}
+	if len(recipients) != len(amounts) {
+		return nil, ErrAmountsLength
+	}
+	// Zero amounts are rejected rather than sent, as they usually stand for a missing value.
+	for idx, amount := range amounts {
+		err := checkAmount(amount)
+		if err == nil && amount.Sign() == 0 {
+			err = ErrZeroAmount
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: amount %d, for %s", err, idx, recipients[idx].Hex())
+		}
+	}
+	if disperse == (common.Address{}) {
+		address, err := d.DisperseAddress(ctx)
+		if err != nil {
+			return nil, err
+		}
+		disperse = address
+	}
+	total := new(big.Int)
+	for _, amount := range amounts {
+		total.Add(total, amount)
+	}
+
+	if err := d.ensureDisperseAllowance(ctx, disperse, total, opts.TopUpAllowance); err != nil {
+		return nil, err
+	}
+
+	contract := inferences.NewDisperse()
+	calldata, err := d.disperseCalldata(ctx, contract, disperse, recipients, amounts, opts.Variant)
+	if err != nil {
+		return nil, err
+	}
+
+	disperseSession := &session{
+		erc20:    d.erc20,
+		callOpts: d.callOpts,
+		instance: contract.Instance(d.Backend(), disperse),
+	}
+	tx, err := transaction.TransactCtx(ctx, d, disperseSession, calldata, transaction.DefaultUnpacker)
+	if err != nil {
+		return nil, d.callError("Disperse()", err)
+	}
+	return d.WaitReceipt(ctx, tx, d.Confirmations())
+}
+
+// ensureDisperseAllowance checks that the Disperse contract may spend total tokens, approving it
+// when topUp is set.
+func (d *Interactions) ensureDisperseAllowance(
+	ctx context.Context,
+	disperse common.Address,
+	total *big.Int,
+	topUp bool,
+) error {
+	allowance, err := d.AllowanceCtx(ctx, d.Address, disperse)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(total) >= 0 {
+		return nil
+	}
+	if !topUp {
+		return &InsufficientAllowanceError{Spender: disperse, Allowance: allowance, Needed: total}
+	}
+
+	tx, err := d.ApproveCtx(ctx, disperse, total)
+	if err != nil {
+		return err
+	}
+	_, err = d.WaitReceipt(ctx, tx, d.Confirmations())
+	return err
+}
+
+// disperseCalldata packs the call of the requested variant. DisperseAuto estimates both variants and
+// keeps the cheapest one able to execute.
+func (d *Interactions) disperseCalldata(
+	ctx context.Context,
+	contract *inferences.Disperse,
+	disperse common.Address,
+	recipients []common.Address,
+	amounts []*big.Int,
+	variant DisperseVariant,
+) ([]byte, error) {
+	token := contract.PackDisperseToken(d.erc20Address, recipients, amounts)
+	simple := contract.PackDisperseTokenSimple(d.erc20Address, recipients, amounts)
+	switch variant {
+	case DisperseToken:
+		return token, nil
+	case DisperseTokenSimple:
+		return simple, nil
+	case DisperseAuto:
+	default:
+		return nil, fmt.Errorf("unknown disperse variant %d", variant)
+	}
+
+	estimate := func(calldata []byte) (uint64, error) {
+		return d.Client.EstimateGas(ctx, ethereum.CallMsg{From: d.Address, To: &disperse, Data: calldata})
+	}
+	tokenGas, tokenErr := estimate(token)
+	simpleGas, simpleErr := estimate(simple)
+	switch {
+	case tokenErr == nil && (simpleErr != nil || tokenGas <= simpleGas):
+		return token, nil
+	case simpleErr == nil:
+		return simple, nil
+	default:
+		return nil, d.callError("Disperse()", tokenErr)
+	}
+}
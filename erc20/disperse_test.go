@@ -0,0 +1,166 @@
+package erc20_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/erc20"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_DisperseTokens verifies that tokens are dispersed with per-recipient amounts through both
+// Disperse variants, and that the allowance is checked or topped up.
+func Test_DisperseTokens(t *testing.T) {
+	backend, auth, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	disperseAddress, err := testingtools.DeployDisperse(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	baseInteractions.SetPollInterval(10 * time.Millisecond)
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stop := testingtools.AutoCommit(backend, 20*time.Millisecond)
+	defer stop()
+
+	recipients := []common.Address{
+		common.HexToAddress("0x1001"),
+		common.HexToAddress("0x1002"),
+		common.HexToAddress("0x1003"),
+	}
+	amounts := []*big.Int{big.NewInt(1), big.NewInt(20), big.NewInt(300)}
+	balance, err := token.GetBalance()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The Disperse contract is not approved yet.
+	_, err = token.DisperseTokens(*disperseAddress, recipients, amounts, erc20.DisperseOptions{})
+	var allowanceErr *erc20.InsufficientAllowanceError
+	if assert.ErrorAs(t, err, &allowanceErr) {
+		assert.Equal(t, *disperseAddress, allowanceErr.Spender)
+		assert.Equal(t, 0, allowanceErr.Needed.Cmp(big.NewInt(321)))
+	}
+
+	testCases := []struct {
+		Name          string
+		Recipients    []common.Address
+		Amounts       []*big.Int
+		Options       erc20.DisperseOptions
+		Received      int64
+		ExpectError   bool
+		ExpectedError error
+	}{
+		{
+			Name:          "KO - No recipient",
+			Options:       erc20.DisperseOptions{TopUpAllowance: true},
+			ExpectError:   true,
+			ExpectedError: erc20.ErrNoRecipients,
+		},
+		{
+			Name:          "KO - Lengths differ",
+			Recipients:    recipients,
+			Amounts:       amounts[:2],
+			Options:       erc20.DisperseOptions{TopUpAllowance: true},
+			ExpectError:   true,
+			ExpectedError: erc20.ErrAmountsLength,
+		},
+		{
+			Name:          "KO - Nil amount",
+			Recipients:    recipients,
+			Amounts:       []*big.Int{big.NewInt(1), nil, big.NewInt(300)},
+			Options:       erc20.DisperseOptions{TopUpAllowance: true},
+			ExpectError:   true,
+			ExpectedError: erc20.ErrNilAmount,
+		},
+		{
+			Name:          "KO - Negative amount",
+			Recipients:    recipients,
+			Amounts:       []*big.Int{big.NewInt(1), big.NewInt(-20), big.NewInt(300)},
+			Options:       erc20.DisperseOptions{TopUpAllowance: true},
+			ExpectError:   true,
+			ExpectedError: erc20.ErrNegativeAmount,
+		},
+		{
+			Name:          "KO - Zero amount",
+			Recipients:    recipients,
+			Amounts:       []*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(300)},
+			Options:       erc20.DisperseOptions{TopUpAllowance: true},
+			ExpectError:   true,
+			ExpectedError: erc20.ErrZeroAmount,
+		},
+		{
+			Name:       "OK - Auto variant",
+			Recipients: recipients,
+			Amounts:    amounts,
+			Options:    erc20.DisperseOptions{TopUpAllowance: true},
+			Received:   1,
+		},
+		{
+			Name:       "OK - Token variant",
+			Recipients: recipients,
+			Amounts:    amounts,
+			Options:    erc20.DisperseOptions{Variant: erc20.DisperseToken, TopUpAllowance: true},
+			Received:   2,
+		},
+		{
+			Name:       "OK - Simple variant",
+			Recipients: recipients,
+			Amounts:    amounts,
+			Options:    erc20.DisperseOptions{Variant: erc20.DisperseTokenSimple, TopUpAllowance: true},
+			Received:   3,
+		},
+		{
+			Name:        "KO - Insufficient balance",
+			Recipients:  recipients[:1],
+			Amounts:     []*big.Int{new(big.Int).Add(balance, big.NewInt(1))},
+			Options:     erc20.DisperseOptions{TopUpAllowance: true},
+			ExpectError: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			receipt, err := token.DisperseTokens(*disperseAddress, tt.Recipients, tt.Amounts, tt.Options)
+			if tt.ExpectError {
+				assert.Error(t, err)
+				if tt.ExpectedError != nil {
+					assert.ErrorIs(t, err, tt.ExpectedError)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, uint64(1), receipt.Status)
+
+			// Each successful case disperses the amounts once more.
+			for idx, recipient := range tt.Recipients {
+				received, err := token.BalanceOf(recipient)
+				assert.Nil(t, err)
+				expected := new(big.Int).Mul(tt.Amounts[idx], big.NewInt(tt.Received))
+				assert.Equal(t, 0, expected.Cmp(received))
+			}
+		})
+	}
+
+}
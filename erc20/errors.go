@@ -0,0 +1,37 @@
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// InsufficientAllowanceError is the ERC20InsufficientAllowance error: the allowance granted to Spender
+// does not cover the Needed amount. It is returned both for reverts and by the checks run before sending.
+type InsufficientAllowanceError struct {
+	Spender   common.Address
+	Allowance *big.Int
+	Needed    *big.Int
+}
+
+func (e *InsufficientAllowanceError) Error() string {
+	return fmt.Sprintf(
+		"ERC20InsufficientAllowance: %s, allowance %s, required: %s",
+		e.Spender.Hex(),
+		e.Allowance.String(),
+		e.Needed.String(),
+	)
+}
+
+// InsufficientBalanceError is the ERC20InsufficientBalance error: the Balance of Sender does not cover
+// the Needed amount. It is returned both for reverts and by the checks run before sending.
+type InsufficientBalanceError struct {
+	Sender  common.Address
+	Balance *big.Int
+	Needed  *big.Int
+}
+
+func (e *InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("ERC20InsufficientBalance: %s, required: %s", e.Balance.String(), e.Needed.String())
+}
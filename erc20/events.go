@@ -0,0 +1,150 @@
+package erc20
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// TransferEventID is the topic of the ERC20 Transfer event.
+	TransferEventID = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// ApprovalEventID is the topic of the ERC20 Approval event.
+	ApprovalEventID = crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+)
+
+// FilterTransfers returns the Transfer events from any of the from addresses to any of the to addresses
+// emitted between fromBlock and toBlock, the latest block when nil. Empty address lists match any address.
+// Block ranges rejected by the node are split until accepted.
+func (d *Interactions) FilterTransfers(
+	from, to []common.Address,
+	fromBlock uint64,
+	toBlock *uint64,
+) ([]*inferences.Ierc20Transfer, error) {
+	return d.FilterTransfersCtx(d.Ctx, from, to, fromBlock, toBlock)
+}
+
+// FilterTransfersCtx returns the Transfer events matching the filters using ctx.
+func (d *Interactions) FilterTransfersCtx(
+	ctx context.Context,
+	from, to []common.Address,
+	fromBlock uint64,
+	toBlock *uint64,
+) ([]*inferences.Ierc20Transfer, error) {
+	logs, err := transaction.FilterLogs(ctx, d.Client, d.eventQuery(TransferEventID, from, to, fromBlock, toBlock))
+	if err != nil {
+		return nil, d.callError("FilterTransfers()", err)
+	}
+	return transaction.DecodeLogs(logs, d.erc20.UnpackTransferEvent)
+}
+
+// FilterTransfersPage returns at most limit Transfer events matching the filters, starting from cursor,
+// and the cursor of the next page, nil once toBlock, the latest block when nil, is reached.
+func (d *Interactions) FilterTransfersPage(
+	from, to []common.Address,
+	cursor transaction.LogCursor,
+	toBlock *uint64,
+	limit int,
+) ([]*inferences.Ierc20Transfer, *transaction.LogCursor, error) {
+	return d.FilterTransfersPageCtx(d.Ctx, from, to, cursor, toBlock, limit)
+}
+
+// FilterTransfersPageCtx returns a page of the Transfer events matching the filters using ctx.
+func (d *Interactions) FilterTransfersPageCtx(
+	ctx context.Context,
+	from, to []common.Address,
+	cursor transaction.LogCursor,
+	toBlock *uint64,
+	limit int,
+) ([]*inferences.Ierc20Transfer, *transaction.LogCursor, error) {
+	query := d.eventQuery(TransferEventID, from, to, cursor.Block, toBlock)
+	logs, next, err := transaction.FilterLogsPage(ctx, d.Client, query, cursor, limit)
+	if err != nil {
+		return nil, nil, d.callError("FilterTransfers()", err)
+	}
+	transfers, err := transaction.DecodeLogs(logs, d.erc20.UnpackTransferEvent)
+	if err != nil {
+		return nil, nil, err
+	}
+	return transfers, next, nil
+}
+
+// FilterApprovals returns the Approval events from any of the owners to any of the spenders emitted
+// between fromBlock and toBlock, the latest block when nil. Empty address lists match any address.
+// Block ranges rejected by the node are split until accepted.
+func (d *Interactions) FilterApprovals(
+	owner, spender []common.Address,
+	fromBlock uint64,
+	toBlock *uint64,
+) ([]*inferences.Ierc20Approval, error) {
+	return d.FilterApprovalsCtx(d.Ctx, owner, spender, fromBlock, toBlock)
+}
+
+// FilterApprovalsCtx returns the Approval events matching the filters using ctx.
+func (d *Interactions) FilterApprovalsCtx(
+	ctx context.Context,
+	owner, spender []common.Address,
+	fromBlock uint64,
+	toBlock *uint64,
+) ([]*inferences.Ierc20Approval, error) {
+	query := d.eventQuery(ApprovalEventID, owner, spender, fromBlock, toBlock)
+	logs, err := transaction.FilterLogs(ctx, d.Client, query)
+	if err != nil {
+		return nil, d.callError("FilterApprovals()", err)
+	}
+	return transaction.DecodeLogs(logs, d.erc20.UnpackApprovalEvent)
+}
+
+// FilterApprovalsPage returns at most limit Approval events matching the filters, starting from cursor,
+// and the cursor of the next page, nil once toBlock, the latest block when nil, is reached.
+func (d *Interactions) FilterApprovalsPage(
+	owner, spender []common.Address,
+	cursor transaction.LogCursor,
+	toBlock *uint64,
+	limit int,
+) ([]*inferences.Ierc20Approval, *transaction.LogCursor, error) {
+	return d.FilterApprovalsPageCtx(d.Ctx, owner, spender, cursor, toBlock, limit)
+}
+
+// FilterApprovalsPageCtx returns a page of the Approval events matching the filters using ctx.
+func (d *Interactions) FilterApprovalsPageCtx(
+	ctx context.Context,
+	owner, spender []common.Address,
+	cursor transaction.LogCursor,
+	toBlock *uint64,
+	limit int,
+) ([]*inferences.Ierc20Approval, *transaction.LogCursor, error) {
+	query := d.eventQuery(ApprovalEventID, owner, spender, cursor.Block, toBlock)
+	logs, next, err := transaction.FilterLogsPage(ctx, d.Client, query, cursor, limit)
+	if err != nil {
+		return nil, nil, d.callError("FilterApprovals()", err)
+	}
+	approvals, err := transaction.DecodeLogs(logs, d.erc20.UnpackApprovalEvent)
+	if err != nil {
+		return nil, nil, err
+	}
+	return approvals, next, nil
+}
+
+// eventQuery builds the log query of the event emitted by the token, filtered on its two indexed addresses.
+func (d *Interactions) eventQuery(
+	eventID common.Hash,
+	first, second []common.Address,
+	fromBlock uint64,
+	toBlock *uint64,
+) ethereum.FilterQuery {
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		Addresses: []common.Address{d.erc20Address},
+		Topics:    [][]common.Hash{{eventID}, transaction.AddressTopics(first), transaction.AddressTopics(second)},
+	}
+	if toBlock != nil {
+		query.ToBlock = new(big.Int).SetUint64(*toBlock)
+	}
+	return query
+}
@@ -0,0 +1,138 @@
+package erc20_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/erc20"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_FilterEvents verifies that Transfer and Approval events are decoded and filtered on their
+// indexed addresses, in full or page by page.
+func Test_FilterEvents(t *testing.T) {
+	backend, auth, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := common.HexToAddress("0x1001")
+	second := common.HexToAddress("0x1002")
+	for idx, to := range []common.Address{first, second, first, second, first} {
+		if _, err := token.TransferTo(to, big.NewInt(int64(idx+1))); err != nil {
+			t.Fatal(err)
+		}
+		backend.Commit()
+	}
+	if _, err := token.Approve(second, big.NewInt(42)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	testCases := []struct {
+		Name           string
+		From           []common.Address
+		To             []common.Address
+		ExpectedValues []int64
+	}{
+		{
+			Name:           "OK - Any address",
+			ExpectedValues: []int64{100_000_000, 1, 2, 3, 4, 5},
+		},
+		{
+			Name:           "OK - Minting only",
+			From:           []common.Address{{}},
+			ExpectedValues: []int64{100_000_000},
+		},
+		{
+			Name:           "OK - Single recipient",
+			From:           []common.Address{auth.From},
+			To:             []common.Address{first},
+			ExpectedValues: []int64{1, 3, 5},
+		},
+		{
+			Name:           "OK - Several recipients",
+			To:             []common.Address{first, second},
+			ExpectedValues: []int64{1, 2, 3, 4, 5},
+		},
+		{
+			Name: "OK - No match",
+			To:   []common.Address{common.HexToAddress("0x1003")},
+		},
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	values := func(transfers []*inferences.Ierc20Transfer) []int64 {
+		var result []int64
+		for _, transfer := range transfers {
+			value := transfer.Value
+			if value.Cmp(unit) > 0 {
+				value = new(big.Int).Div(value, unit)
+			}
+			result = append(result, value.Int64())
+		}
+		return result
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			transfers, err := token.FilterTransfers(tt.From, tt.To, 0, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.ExpectedValues, values(transfers))
+
+			var paged []*inferences.Ierc20Transfer
+			cursor := &transaction.LogCursor{}
+			for cursor != nil {
+				var page []*inferences.Ierc20Transfer
+				page, cursor, err = token.FilterTransfersPage(tt.From, tt.To, *cursor, nil, 2)
+				if !assert.Nil(t, err) {
+					return
+				}
+				paged = append(paged, page...)
+			}
+			assert.Equal(t, tt.ExpectedValues, values(paged))
+		})
+	}
+
+	// Bounded ranges exclude the later blocks.
+	head, err := backend.Client().BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	toBlock := head - 2
+	transfers, err := token.FilterTransfers(nil, []common.Address{first, second}, 0, &toBlock)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4}, values(transfers))
+
+	approvals, err := token.FilterApprovals([]common.Address{auth.From}, nil, 0, nil)
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, len(approvals)) {
+		assert.Equal(t, second, approvals[0].Spender)
+		assert.Equal(t, int64(42), approvals[0].Value.Int64())
+	}
+
+	approvals, next, err := token.FilterApprovalsPage(nil, []common.Address{first}, transaction.LogCursor{}, nil, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, approvals)
+	assert.Nil(t, next)
+}
@@ -0,0 +1,324 @@
+// Package permit provides functions to sign and submit ERC20 permits (EIP-2612).
+package permit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/customerrors"
+	"github.com/Thektonic/eth-interfaces/eip712"
+	"github.com/Thektonic/eth-interfaces/erc20"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// DefaultVersion is the EIP-712 domain version assumed for tokens not implementing ERC-5267.
+const DefaultVersion = "1"
+
+// signatureLength is the length of a [R || S || V] signature.
+const signatureLength = 65
+
+var (
+	// ErrDomainMismatch is returned when the domain the permit would be signed for does not hash to the
+	// token's DOMAIN_SEPARATOR, which would make the contract reject the signature.
+	ErrDomainMismatch = errors.New("EIP-712 domain does not match DOMAIN_SEPARATOR")
+)
+
+// NonceMismatchError is returned when submitting a permit signed for another nonce than the owner's
+// current one, which the contract would reject.
+type NonceMismatchError struct {
+	Owner   common.Address
+	Signed  *big.Int
+	Current *big.Int
+}
+
+func (e *NonceMismatchError) Error() string {
+	return fmt.Sprintf("permit of %s signed for nonce %s, current nonce is %s", e.Owner.Hex(), e.Signed, e.Current)
+}
+
+// SignedPermit is a permit signed by Owner, which anyone can submit.
+type SignedPermit struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+	V        uint8
+	R        [32]byte
+	S        [32]byte
+}
+
+// IERC20PermitInteractions wraps interactions with an IERC20Permit contract, extending basic ERC20 interactions.
+type IERC20PermitInteractions struct {
+	*erc20.Interactions
+	erc20Permit *inferences.Ierc20permit
+	callError   func(string, error) error
+}
+
+// NewIERC20Permit creates a new permit interaction instance using the provided base ERC20 interactions.
+func NewIERC20Permit(
+	baseIERC20 *erc20.Interactions,
+	signatures []ERC20PermitSignatures,
+) (*IERC20PermitInteractions, error) {
+	var converted []hex.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err := baseIERC20.CheckSignatures(baseIERC20.GetAddress(), converted)
+	if err != nil {
+		return nil, customerrors.WrapInterfacingError("ierc20Permit", err)
+	}
+
+	erc20Permit := inferences.NewIerc20permit()
+
+	callError := base.GenCallError("erc20Permit", ParseError, erc20Permit.UnpackError)
+
+	return &IERC20PermitInteractions{baseIERC20, erc20Permit, callError}, nil
+}
+
+// Nonces returns the nonce the next permit of owner must be signed for.
+func (e *IERC20PermitInteractions) Nonces(owner common.Address) (*big.Int, error) {
+	return e.NoncesCtx(e.Ctx, owner)
+}
+
+// NoncesCtx returns the nonce the next permit of owner must be signed for using ctx.
+func (e *IERC20PermitInteractions) NoncesCtx(ctx context.Context, owner common.Address) (*big.Int, error) {
+	nonce, err := transaction.CallCtx(
+		ctx,
+		e,
+		e.erc20Permit.PackNonces(owner),
+		e.erc20Permit.UnpackNonces,
+	)
+	if err != nil {
+		return nil, e.callError("Nonces()", err)
+	}
+	return nonce, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator the token hashes permits with.
+func (e *IERC20PermitInteractions) DomainSeparator() (common.Hash, error) {
+	return e.DomainSeparatorCtx(e.Ctx)
+}
+
+// DomainSeparatorCtx returns the EIP-712 domain separator of the token using ctx.
+func (e *IERC20PermitInteractions) DomainSeparatorCtx(ctx context.Context) (common.Hash, error) {
+	separator, err := transaction.CallCtx(
+		ctx,
+		e,
+		e.erc20Permit.PackDOMAINSEPARATOR(),
+		e.erc20Permit.UnpackDOMAINSEPARATOR,
+	)
+	if err != nil {
+		return common.Hash{}, e.callError("DomainSeparator()", err)
+	}
+	return separator, nil
+}
+
+// EIP712Domain returns the EIP-712 domain the token reports through ERC-5267.
+func (e *IERC20PermitInteractions) EIP712Domain() (*eip712.Domain, error) {
+	return e.EIP712DomainCtx(e.Ctx)
+}
+
+// EIP712DomainCtx returns the EIP-712 domain the token reports through ERC-5267 using ctx.
+func (e *IERC20PermitInteractions) EIP712DomainCtx(ctx context.Context) (*eip712.Domain, error) {
+	domain, err := transaction.CallCtx(
+		ctx,
+		e,
+		e.erc20Permit.PackEip712Domain(),
+		e.erc20Permit.UnpackEip712Domain,
+	)
+	if err != nil {
+		return nil, e.callError("EIP712Domain()", err)
+	}
+	return &eip712.Domain{
+		Fields:            domain.Fields[0],
+		Name:              domain.Name,
+		Version:           domain.Version,
+		ChainID:           domain.ChainId,
+		VerifyingContract: domain.VerifyingContract,
+		Salt:              domain.Salt,
+		Extensions:        domain.Extensions,
+	}, nil
+}
+
+// SignPermit signs a permit allowing spender to spend value tokens of the interactions' address until
+// deadline, a Unix timestamp, for the owner's current nonce.
+func (e *IERC20PermitInteractions) SignPermit(
+	spender common.Address,
+	value *big.Int,
+	deadline *big.Int,
+) (*SignedPermit, error) {
+	return e.SignPermitCtx(e.Ctx, spender, value, deadline)
+}
+
+// SignPermitCtx signs a permit like SignPermit using ctx.
+func (e *IERC20PermitInteractions) SignPermitCtx(
+	ctx context.Context,
+	spender common.Address,
+	value *big.Int,
+	deadline *big.Int,
+) (*SignedPermit, error) {
+	var nonce *big.Int
+	var domain *eip712.Domain
+	var nonceErr, domainErr error
+	e.RunReads(
+		func() { nonce, nonceErr = e.NoncesCtx(ctx, e.Address) },
+		func() { domain, domainErr = e.domainCtx(ctx) },
+	)
+	if nonceErr != nil {
+		return nil, nonceErr
+	}
+	if domainErr != nil {
+		return nil, domainErr
+	}
+
+	permit := &SignedPermit{Owner: e.Address, Spender: spender, Value: value, Nonce: nonce, Deadline: deadline}
+	signature, err := e.SignTypedDataCtx(ctx, permit.typedData(domain))
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != signatureLength {
+		return nil, fmt.Errorf("invalid permit signature length %d", len(signature))
+	}
+	copy(permit.R[:], signature[:32])
+	copy(permit.S[:], signature[32:64])
+	permit.V = signature[crypto.RecoveryIDOffset]
+	return permit, nil
+}
+
+// Permit submits the signed permit, the interactions' address paying for the transaction as a relayer.
+// Permits signed for another nonce than the owner's current one are rejected before being sent.
+func (e *IERC20PermitInteractions) Permit(permit *SignedPermit) (*types.Transaction, error) {
+	return e.PermitCtx(e.Ctx, permit)
+}
+
+// PermitCtx submits the signed permit like Permit using ctx.
+func (e *IERC20PermitInteractions) PermitCtx(ctx context.Context, permit *SignedPermit) (*types.Transaction, error) {
+	nonce, err := e.NoncesCtx(ctx, permit.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if nonce.Cmp(permit.Nonce) != 0 {
+		return nil, &NonceMismatchError{Owner: permit.Owner, Signed: permit.Nonce, Current: nonce}
+	}
+
+	tx, err := transaction.TransactCtx(
+		ctx,
+		e,
+		e,
+		e.erc20Permit.PackPermit(
+			permit.Owner,
+			permit.Spender,
+			permit.Value,
+			permit.Deadline,
+			permit.V,
+			permit.R,
+			permit.S,
+		),
+		transaction.DefaultUnpacker,
+	)
+	if err != nil {
+		return nil, e.callError("Permit()", err)
+	}
+	return tx, nil
+}
+
+// domainCtx returns the domain permits are signed for. Tokens not implementing ERC-5267 are assumed to
+// use their name, DefaultVersion, the chain ID and their address. Either way the domain must hash to
+// the token's DOMAIN_SEPARATOR.
+func (e *IERC20PermitInteractions) domainCtx(ctx context.Context) (*eip712.Domain, error) {
+	var domain *eip712.Domain
+	var separator common.Hash
+	var domainErr, separatorErr error
+	e.RunReads(
+		func() { domain, domainErr = e.EIP712DomainCtx(ctx) },
+		func() { separator, separatorErr = e.DomainSeparatorCtx(ctx) },
+	)
+	if separatorErr != nil {
+		return nil, separatorErr
+	}
+	if domainErr != nil {
+		name, err := e.NameCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		chainID, err := e.Client.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chain ID: %w", err)
+		}
+		domain = eip712.NewDomain(name, DefaultVersion, chainID, e.GetAddress())
+	}
+
+	hash, err := domain.Separator()
+	if err != nil {
+		return nil, err
+	}
+	if hash != separator {
+		return nil, ErrDomainMismatch
+	}
+	return domain, nil
+}
+
+// typedData returns the permit as EIP-712 typed data for the domain.
+func (p *SignedPermit) typedData(domain *eip712.Domain) apitypes.TypedData {
+	typedDomain, fields := domain.TypedData()
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": fields,
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain:      typedDomain,
+		Message: apitypes.TypedDataMessage{
+			"owner":    p.Owner.Hex(),
+			"spender":  p.Spender.Hex(),
+			"value":    p.Value,
+			"nonce":    p.Nonce,
+			"deadline": p.Deadline,
+		},
+	}
+}
+
+// ParseError parses raw contract errors into human-readable error messages for ERC20 permit operations.
+func ParseError(rawErr any) error {
+	switch e := rawErr.(type) {
+	case *inferences.Ierc20permitERC2612ExpiredSignature:
+		return fmt.Errorf("ERC2612ExpiredSignature: deadline %s", e.Deadline.String())
+	case *inferences.Ierc20permitERC2612InvalidSigner:
+		return fmt.Errorf("ERC2612InvalidSigner: %s, owner: %s", e.Signer.Hex(), e.Owner.Hex())
+	case *inferences.Ierc20permitERC20InsufficientAllowance:
+		return fmt.Errorf(
+			"ERC20InsufficientAllowance: %s, allowance %s, required: %s",
+			e.Spender.Hex(),
+			e.Allowance.String(),
+			e.Needed.String(),
+		)
+	case *inferences.Ierc20permitERC20InvalidSpender:
+		return fmt.Errorf("ERC20InvalidSpender: %s", e.Spender.Hex())
+	case *inferences.Ierc20permitERC20InsufficientBalance:
+		return fmt.Errorf("ERC20InsufficientBalance: %s, required: %s", e.Balance.String(), e.Needed.String())
+	case *inferences.Ierc20permitERC20InvalidSender:
+		return fmt.Errorf("ERC20InvalidSender: %s", e.Sender.Hex())
+	case *inferences.Ierc20permitERC20InvalidReceiver:
+		return fmt.Errorf("ERC20InvalidReceiver: %s", e.Receiver.Hex())
+	case *inferences.Ierc20permitERC20InvalidApprover:
+		return fmt.Errorf("ERC20InvalidApprover: %s", e.Approver.Hex())
+	default:
+		return nil
+	}
+}
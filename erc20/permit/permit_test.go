@@ -0,0 +1,256 @@
+package permit_test
+
+// Package permit_test contains tests for permit interactions.
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/erc20"
+	"github.com/Thektonic/eth-interfaces/erc20/permit"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+// setup deploys the permit token and returns the owner's interactions and a funded relayer's ones.
+func setup(t *testing.T) (*simulated.Backend, *permit.IERC20PermitInteractions, *permit.IERC20PermitInteractions) {
+	backend, _, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20permitMetaData.ABI,
+		inferences.Ierc20permitMetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	relayerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownerInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	_, err = ownerInteractions.TransferETH(crypto.PubkeyToAddress(relayerKey.PublicKey), big.NewInt(1e18))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	relayerInteractions := base.NewBaseInteractions(backend.Client(), relayerKey, nil, false)
+
+	newPermit := func(baseInteractions *base.Interactions) *permit.IERC20PermitInteractions {
+		erc20Interactions, err := erc20.NewIERC20Interactions(
+			baseInteractions, *contractAddress, []erc20.BaseERC20Signature{erc20.Name, erc20.Approve},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		permitInteractions, err := permit.NewIERC20Permit(
+			erc20Interactions,
+			[]permit.ERC20PermitSignatures{permit.Permit, permit.Nonces, permit.DomainSeparator, permit.EIP712Domain},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return permitInteractions
+	}
+	return backend, newPermit(ownerInteractions), newPermit(relayerInteractions)
+}
+
+// Test_Instantiation verifies that the permit interactions are only created for tokens supporting permits.
+func Test_Instantiation(t *testing.T) {
+	backend, auth, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20permitMetaData.ABI,
+		inferences.Ierc20permitMetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	erc20Address, _, _, err := hex.DeployContract(auth, backend.Client(),
+		inferences.Ierc20MetaData.ABI, inferences.Ierc20MetaData.Bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	testCases := []struct {
+		Name          string
+		ContractAddr  common.Address
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:         "OK - Successfully instantiated",
+			ContractAddr: *contractAddress,
+		},
+		{
+			Name:          "NOK - ERC20 without permits",
+			ContractAddr:  erc20Address,
+			ExpectError:   true,
+			ExpectedError: "ierc20Permit",
+		},
+	}
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			erc20Interactions, err := erc20.NewIERC20Interactions(
+				baseInteractions, tt.ContractAddr, []erc20.BaseERC20Signature{erc20.Approve},
+			)
+			if err != nil {
+				t.Fatalf("failed to create interactions interface, error: %s", err.Error())
+			}
+			_, err = permit.NewIERC20Permit(
+				erc20Interactions,
+				[]permit.ERC20PermitSignatures{permit.Permit, permit.Nonces},
+			)
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+			} else {
+				assert.NoError(t, err, "failed to create interactions interface, error: %w", err)
+			}
+		})
+	}
+}
+
+// Test_Domain verifies that the reported domain hashes to the domain separator.
+func Test_Domain(t *testing.T) {
+	backend, owner, _ := setup(t)
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	domain, err := owner.EIP712Domain()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, byte(0x0f), domain.Fields)
+	assert.Equal(t, "Permit Token", domain.Name)
+	assert.Equal(t, permit.DefaultVersion, domain.Version)
+	assert.Equal(t, int64(hex.TestChainID), domain.ChainID.Int64())
+	assert.Equal(t, owner.GetAddress(), domain.VerifyingContract)
+
+	separator, err := owner.DomainSeparator()
+	assert.Nil(t, err)
+	assert.NotEqual(t, common.Hash{}, separator)
+}
+
+// Test_Permit verifies that permits signed by the owner and submitted by a relayer set the allowance,
+// and that invalid permits are rejected.
+func Test_Permit(t *testing.T) {
+	backend, owner, relayer := setup(t)
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	deadline := big.NewInt(time.Now().Add(time.Hour).Unix())
+	spender := relayer.Address
+	replayed, err := owner.SignPermit(spender, big.NewInt(1), deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := relayer.Permit(replayed); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	testCases := []struct {
+		Name          string
+		Value         *big.Int
+		Deadline      *big.Int
+		Tamper        func(*permit.SignedPermit) *permit.SignedPermit
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:     "OK - Permit submitted by a relayer",
+			Value:    big.NewInt(500),
+			Deadline: deadline,
+		},
+		{
+			Name:          "NOK - Expired deadline",
+			Value:         big.NewInt(500),
+			Deadline:      big.NewInt(1),
+			ExpectError:   true,
+			ExpectedError: "erc20Permit.Permit(): ERC2612ExpiredSignature: deadline 1",
+		},
+		{
+			Name:     "NOK - Value changed after signing",
+			Value:    big.NewInt(500),
+			Deadline: deadline,
+			Tamper: func(signed *permit.SignedPermit) *permit.SignedPermit {
+				signed.Value = big.NewInt(501)
+				return signed
+			},
+			ExpectError:   true,
+			ExpectedError: "erc20Permit.Permit(): ERC2612InvalidSigner",
+		},
+		{
+			Name:     "NOK - Permit already used",
+			Value:    big.NewInt(500),
+			Deadline: deadline,
+			Tamper: func(*permit.SignedPermit) *permit.SignedPermit {
+				return replayed
+			},
+			ExpectError:   true,
+			ExpectedError: "signed for nonce 0, current nonce is 2",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			nonce, err := owner.Nonces(owner.Address)
+			if err != nil {
+				t.Fatal(err)
+			}
+			signed, err := owner.SignPermit(spender, tt.Value, tt.Deadline)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, nonce, signed.Nonce)
+			if tt.Tamper != nil {
+				signed = tt.Tamper(signed)
+			}
+
+			_, err = relayer.Permit(signed)
+			backend.Commit()
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+				return
+			}
+			assert.Nil(t, err)
+			allowance, err := relayer.Allowance(owner.Address, spender)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.Value, allowance)
+			next, err := owner.Nonces(owner.Address)
+			assert.Nil(t, err)
+			assert.Equal(t, new(big.Int).Add(nonce, common.Big1), next)
+		})
+	}
+
+	var mismatch *permit.NonceMismatchError
+	_, err = relayer.Permit(replayed)
+	assert.True(t, errors.As(err, &mismatch))
+}
@@ -0,0 +1,43 @@
+// Package permit provides functions to sign and submit ERC20 permits (EIP-2612).
+package permit
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ERC20PermitSignatures represents function signatures for ERC20 permit operations
+type ERC20PermitSignatures string
+
+const (
+	// Permit represents the permit function signature for approving through a signed message
+	Permit ERC20PermitSignatures = "permit(address,address,uint256,uint256,uint8,bytes32,bytes32)"
+	// Nonces represents the nonces function signature returning the next permit nonce of an owner
+	Nonces ERC20PermitSignatures = "nonces(address)"
+	// DomainSeparator represents the DOMAIN_SEPARATOR function signature
+	DomainSeparator ERC20PermitSignatures = "DOMAIN_SEPARATOR()"
+	// EIP712Domain represents the ERC-5267 eip712Domain function signature
+	EIP712Domain ERC20PermitSignatures = "eip712Domain()"
+)
+
+// computeHash returns the Keccak256 hash of the function signature
+func (s ERC20PermitSignatures) computeHash() []byte {
+	hash := crypto.NewKeccakState()
+	_, _ = hash.Write([]byte(s)) // hash.Write never returns an error
+	return hash.Sum(nil)
+}
+
+// GetHex returns the hex representation of the function signature
+func (s ERC20PermitSignatures) GetHex() string {
+	return hex.EncodeToString(s.computeHash())
+}
+
+func (s ERC20PermitSignatures) String() string {
+	return string(s)
+}
+
+// GetSelector returns the Keccak256 hash selector for the ERC20 permit signature
+func (s ERC20PermitSignatures) GetSelector() []byte {
+	return s.computeHash()[:4]
+}
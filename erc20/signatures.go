@@ -27,6 +27,10 @@ const (
 	Approve BaseERC20Signature = "approve(address,uint256)"
 	// TransferFrom represents the transferFrom function signature
 	TransferFrom BaseERC20Signature = "transferFrom(address,address,uint256)"
+	// IncreaseAllowance represents the increaseAllowance function signature
+	IncreaseAllowance BaseERC20Signature = "increaseAllowance(address,uint256)"
+	// DecreaseAllowance represents the decreaseAllowance function signature
+	DecreaseAllowance BaseERC20Signature = "decreaseAllowance(address,uint256)"
 	// SafeTransferFrom represents the safeTransferFrom function signature
 	SafeTransferFrom BaseERC20Signature = "safeTransferFrom(address,address,uint256)"
 )
@@ -37,7 +37,11 @@ func Example1() {
 	defer client.Close()
 
 	// Create a new base interaction object
-	baseInteractions := base.NewBaseInteractions(client, privateKey, nil, false)
+	baseInteractions, err := base.NewInteractions(client, base.WithPrivateKey(privateKey))
+	if err != nil {
+		log.Printf("error creating the base interactions: %v", err)
+		return
+	}
 
 	// Create a new ERC721 interaction object from the base interaction
 	nftInteractions, err := nft.NewERC721Interactions(
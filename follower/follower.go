@@ -0,0 +1,219 @@
+// Package follower provides a reorg-safe stream of contract events following the chain block by block.
+package follower
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// DefaultDepth is the default number of processed blocks kept to handle reorganisations.
+	DefaultDepth = 64
+	// DefaultPollInterval is the default interval the chain head is polled at.
+	DefaultPollInterval = 2 * time.Second
+)
+
+// ErrReorgTooDeep is returned when a reorganisation reaches beyond the blocks kept by the follower.
+var ErrReorgTooDeep = errors.New("reorganisation deeper than the followed blocks")
+
+// Client is implemented by the clients a follower reads blocks and logs from.
+type Client interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Event is a decoded log delivered by a follower. Removed events report the logs of blocks that left
+// the canonical chain, delivered in reverse order before the logs of the new canonical blocks.
+type Event[T any] struct {
+	Value   T
+	Log     types.Log
+	Removed bool
+}
+
+// Config configures a follower.
+type Config struct {
+	// Query selects the followed logs by address and topics, its block fields are ignored.
+	Query ethereum.FilterQuery
+	// StartBlock is the first block processed when the store holds no checkpoint.
+	StartBlock uint64
+	// Depth is the number of processed blocks kept to handle reorganisations, DefaultDepth when 0.
+	Depth int
+	// PollInterval is the interval Run polls the chain head at, DefaultPollInterval when 0.
+	PollInterval time.Duration
+	// Store persists the checkpoint, in memory when nil.
+	Store Store
+}
+
+// Follower processes the chain block by block, delivering the decoded logs matching its query. The
+// checkpoint is saved once the events of a block were handled, so that a restarted follower resumes
+// after the last handled block.
+type Follower[T any] struct {
+	client     Client
+	config     Config
+	decode     func(*types.Log) (T, error)
+	checkpoint *Checkpoint
+}
+
+// New creates a follower decoding the logs with decode, such as the Unpack*Event helpers of the bindings.
+func New[T any](client Client, config Config, decode func(*types.Log) (T, error)) *Follower[T] {
+	if config.Depth < 1 {
+		config.Depth = DefaultDepth
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+	if config.Store == nil {
+		config.Store = &MemoryStore{}
+	}
+	config.Query.FromBlock, config.Query.ToBlock, config.Query.BlockHash = nil, nil, nil
+	return &Follower[T]{client: client, config: config, decode: decode}
+}
+
+// Run processes the chain until ctx is done or handle fails, polling the head at the configured interval.
+func (f *Follower[T]) Run(ctx context.Context, handle func(Event[T]) error) error {
+	ticker := time.NewTicker(f.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := f.Poll(ctx, handle); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll processes the blocks up to the current head. Blocks that left the canonical chain are unwound
+// first, their events delivered as removed.
+func (f *Follower[T]) Poll(ctx context.Context, handle func(Event[T]) error) error {
+	if f.checkpoint == nil {
+		checkpoint, err := f.config.Store.Load(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load checkpoint: %w", err)
+		}
+		if checkpoint == nil {
+			checkpoint = &Checkpoint{}
+		}
+		f.checkpoint = checkpoint
+	}
+
+	for {
+		last := f.checkpoint.Last()
+		next := f.config.StartBlock
+		if last != nil {
+			next = last.Number + 1
+		}
+
+		header, err := f.client.HeaderByNumber(ctx, new(big.Int).SetUint64(next))
+		if errors.Is(err, ethereum.NotFound) {
+			if last == nil {
+				return nil
+			}
+			// The head was reached, unless the chain was reorganised into a shorter one.
+			canonical, err := f.canonical(ctx, last)
+			if err != nil || canonical {
+				return err
+			}
+			if err := f.unwind(ctx, handle); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if last != nil && header.ParentHash != last.Hash {
+			if err := f.unwind(ctx, handle); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := f.process(ctx, header, handle); err != nil {
+			return err
+		}
+	}
+}
+
+// canonical reports whether the block is still part of the canonical chain.
+func (f *Follower[T]) canonical(ctx context.Context, block *Block) (bool, error) {
+	header, err := f.client.HeaderByNumber(ctx, new(big.Int).SetUint64(block.Number))
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return header.Hash() == block.Hash, nil
+}
+
+// process delivers the events of the block and records it.
+func (f *Follower[T]) process(ctx context.Context, header *types.Header, handle func(Event[T]) error) error {
+	hash := header.Hash()
+	query := f.config.Query
+	query.BlockHash = &hash
+	logs, err := f.client.FilterLogs(ctx, query)
+	if err != nil {
+		return err
+	}
+	for _, log := range logs {
+		if err := f.deliver(log, false, handle); err != nil {
+			return err
+		}
+	}
+
+	blocks := make([]Block, 0, len(f.checkpoint.Blocks)+1)
+	blocks = append(blocks, f.checkpoint.Blocks...)
+	blocks = append(blocks, Block{Number: header.Number.Uint64(), Hash: hash, Logs: logs})
+	if len(blocks) > f.config.Depth {
+		blocks = blocks[len(blocks)-f.config.Depth:]
+	}
+	return f.save(ctx, &Checkpoint{Blocks: blocks})
+}
+
+// unwind delivers the events of the last processed block as removed and forgets the block.
+func (f *Follower[T]) unwind(ctx context.Context, handle func(Event[T]) error) error {
+	last := f.checkpoint.Last()
+	if len(f.checkpoint.Blocks) == 1 && last.Number > f.config.StartBlock {
+		return fmt.Errorf("%w: block %d (%s)", ErrReorgTooDeep, last.Number, last.Hash.Hex())
+	}
+	for idx := len(last.Logs) - 1; idx >= 0; idx-- {
+		if err := f.deliver(last.Logs[idx], true, handle); err != nil {
+			return err
+		}
+	}
+	blocks := f.checkpoint.Blocks[:len(f.checkpoint.Blocks)-1]
+	return f.save(ctx, &Checkpoint{Blocks: blocks})
+}
+
+// deliver decodes the log and hands the event to handle.
+func (f *Follower[T]) deliver(log types.Log, removed bool, handle func(Event[T]) error) error {
+	log.Removed = removed
+	value, err := f.decode(&log)
+	if err != nil {
+		return fmt.Errorf("failed to decode log %d of block %d: %w", log.Index, log.BlockNumber, err)
+	}
+	return handle(Event[T]{Value: value, Log: log, Removed: removed})
+}
+
+// save persists the checkpoint and makes it current.
+func (f *Follower[T]) save(ctx context.Context, checkpoint *Checkpoint) error {
+	if err := f.config.Store.Save(ctx, checkpoint); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	f.checkpoint = checkpoint
+	return nil
+}
+
+// Checkpoint returns the last processed block, nil before the first one.
+func (f *Follower[T]) Checkpoint() *Block {
+	return f.checkpoint.Last()
+}
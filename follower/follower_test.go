@@ -0,0 +1,174 @@
+package follower_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/follower"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum"
+	bind2 "github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Follower verifies that the follower delivers the logs once, reports the logs of reorganised
+// blocks as removed and resumes from its checkpoint.
+func Test_Follower(t *testing.T) {
+	backend, auth, contractAddress, _, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	ctx := context.Background()
+	client := backend.Client()
+	ierc20 := inferences.NewIerc20()
+	instance := ierc20.Instance(client, *contractAddress)
+	transfer := func(to common.Address) *types.Transaction {
+		tx, err := bind2.Transact(instance, auth, ierc20.PackTransfer(to, big.NewInt(1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		backend.Commit()
+		return tx
+	}
+
+	config := follower.Config{
+		Query: ethereum.FilterQuery{Addresses: []common.Address{*contractAddress}},
+		Store: &follower.FileStore{Path: filepath.Join(t.TempDir(), "checkpoint.json")},
+	}
+	var events []follower.Event[*inferences.Ierc20Transfer]
+	handle := func(event follower.Event[*inferences.Ierc20Transfer]) error {
+		events = append(events, event)
+		return nil
+	}
+	stream := follower.New(client, config, ierc20.UnpackTransferEvent)
+
+	// The deployment mints the supply.
+	assert.Nil(t, stream.Poll(ctx, handle))
+	if assert.Equal(t, 1, len(events)) {
+		assert.Equal(t, common.Address{}, events[0].Value.From)
+	}
+
+	first := common.HexToAddress("0x1001")
+	tx := transfer(first)
+	events = nil
+	assert.Nil(t, stream.Poll(ctx, handle))
+	if assert.Equal(t, 1, len(events)) {
+		assert.Equal(t, first, events[0].Value.To)
+		assert.False(t, events[0].Removed)
+	}
+
+	// Replace the block of the transfer with a longer side chain.
+	receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent, err := client.HeaderByNumber(ctx, new(big.Int).Sub(receipt.BlockNumber, common.Big1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backend.Fork(parent.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	backend.Commit()
+	backend.Commit()
+
+	events = nil
+	assert.Nil(t, stream.Poll(ctx, handle))
+	if assert.NotEmpty(t, events) {
+		assert.True(t, events[0].Removed)
+		assert.Equal(t, tx.Hash(), events[0].Log.TxHash)
+		assert.Equal(t, receipt.BlockHash, events[0].Log.BlockHash)
+	}
+	// Whether or not the transfer was included again, the delivered events match the canonical logs.
+	canonical, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: receipt.BlockNumber,
+		Addresses: []common.Address{*contractAddress},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, len(canonical), len(events)-1)
+	for _, event := range events[1:] {
+		assert.False(t, event.Removed)
+		assert.NotEqual(t, receipt.BlockHash, event.Log.BlockHash)
+	}
+
+	// A restarted follower resumes after the checkpoint.
+	second := common.HexToAddress("0x1002")
+	transfer(second)
+	restarted := follower.New(client, config, ierc20.UnpackTransferEvent)
+	events = nil
+	assert.Nil(t, restarted.Poll(ctx, handle))
+	if assert.Equal(t, 1, len(events)) {
+		assert.Equal(t, second, events[0].Value.To)
+	}
+	head, err := client.BlockNumber(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, head, restarted.Checkpoint().Number)
+
+	// A failing handler does not move the checkpoint.
+	transfer(first)
+	failure := errors.New("handler failure")
+	err = restarted.Poll(ctx, func(follower.Event[*inferences.Ierc20Transfer]) error { return failure })
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, head, restarted.Checkpoint().Number)
+}
+
+// Test_FollowerReorgTooDeep verifies that reorganisations beyond the kept blocks are reported.
+func Test_FollowerReorgTooDeep(t *testing.T) {
+	backend, _, contractAddress, _, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	ctx := context.Background()
+	client := backend.Client()
+	for range 3 {
+		backend.Commit()
+	}
+	fork, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		backend.Commit()
+	}
+
+	ierc20 := inferences.NewIerc20()
+	stream := follower.New(client, follower.Config{
+		Query: ethereum.FilterQuery{Addresses: []common.Address{*contractAddress}},
+		Depth: 1,
+	}, ierc20.UnpackTransferEvent)
+	handle := func(follower.Event[*inferences.Ierc20Transfer]) error { return nil }
+	assert.Nil(t, stream.Poll(ctx, handle))
+
+	if err := backend.Fork(fork.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	for range 4 {
+		backend.Commit()
+	}
+	assert.ErrorIs(t, stream.Poll(ctx, handle), follower.ErrReorgTooDeep)
+}
@@ -0,0 +1,116 @@
+package follower
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Block is a processed block along with the logs delivered for it.
+type Block struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+	Logs   []types.Log `json:"logs"`
+}
+
+// Checkpoint is the state of a follower: the most recent processed blocks, oldest first. The logs of
+// these blocks are kept so that they can be reported as removed if the blocks are reorganised.
+type Checkpoint struct {
+	Blocks []Block `json:"blocks"`
+}
+
+// Last returns the last processed block, nil when no block was processed.
+func (c *Checkpoint) Last() *Block {
+	if c == nil || len(c.Blocks) == 0 {
+		return nil
+	}
+	return &c.Blocks[len(c.Blocks)-1]
+}
+
+// Store persists the checkpoint of a follower.
+type Store interface {
+	// Load returns the saved checkpoint, nil when none was saved.
+	Load(ctx context.Context) (*Checkpoint, error)
+	// Save persists the checkpoint.
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+}
+
+// MemoryStore keeps the checkpoint in memory.
+type MemoryStore struct {
+	mu         sync.Mutex
+	checkpoint *Checkpoint
+}
+
+// Load returns the saved checkpoint.
+func (s *MemoryStore) Load(context.Context) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return clone(s.checkpoint), nil
+}
+
+// Save keeps a copy of the checkpoint.
+func (s *MemoryStore) Save(_ context.Context, checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint = clone(checkpoint)
+	return nil
+}
+
+// FileStore persists the checkpoint as JSON in the file at Path.
+type FileStore struct {
+	Path string
+}
+
+// Load reads the checkpoint from the file, nil when the file does not exist.
+func (s *FileStore) Load(context.Context) (*Checkpoint, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+// Save writes the checkpoint to a temporary file renamed over the file, so that a crash never leaves
+// a partial checkpoint.
+func (s *FileStore) Save(_ context.Context, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// clone returns a copy of the checkpoint that does not share its block list.
+func clone(checkpoint *Checkpoint) *Checkpoint {
+	if checkpoint == nil {
+		return nil
+	}
+	blocks := make([]Block, len(checkpoint.Blocks))
+	copy(blocks, checkpoint.Blocks)
+	return &Checkpoint{Blocks: blocks}
+}
@@ -0,0 +1,124 @@
+package hex
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Selector is the 4-byte identifier of a contract function.
+type Selector [4]byte
+
+func (s Selector) String() string {
+	return "0x" + hex.EncodeToString(s[:])
+}
+
+// Instruction is a disassembled EVM instruction.
+type Instruction struct {
+	// PC is the offset of the instruction in the code.
+	PC int
+	Op vm.OpCode
+	// Operand holds the data pushed by PUSH instructions, truncated when the code ends first.
+	Operand []byte
+}
+
+func (i Instruction) String() string {
+	if len(i.Operand) == 0 {
+		return fmt.Sprintf("0x%04x %s", i.PC, i.Op)
+	}
+	return fmt.Sprintf("0x%04x %s 0x%s", i.PC, i.Op, hex.EncodeToString(i.Operand))
+}
+
+// metadataKeys are the keys one of which the metadata trailers of compilers contain.
+var metadataKeys = [][]byte{[]byte("ipfs"), []byte("bzzr"), []byte("solc"), []byte("vyper")}
+
+// StripMetadata removes the CBOR-encoded metadata solc and vyper append to the runtime code, whose
+// length is given by the last two bytes of the code. Code without a well-formed trailer is returned as is.
+func StripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	length := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - length
+	// The trailer is a CBOR map or array, encoded as major type 5 or 4 in the top 3 bits of its first byte.
+	if length == 0 || start < 0 || (code[start]>>5 != 5 && code[start]>>5 != 4) {
+		return code
+	}
+	trailer := code[start : len(code)-2]
+	for _, key := range metadataKeys {
+		if bytes.Contains(trailer, key) {
+			return code[:start]
+		}
+	}
+	return code
+}
+
+// Disassemble decodes the code into instructions, skipping the operands of PUSH instructions and the
+// metadata trailer. Bytes not mapping to an opcode are kept as undefined opcodes, which the EVM treats
+// as INVALID.
+func Disassemble(code []byte) []Instruction {
+	code = StripMetadata(code)
+	instructions := make([]Instruction, 0, len(code))
+	for pc := 0; pc < len(code); pc++ {
+		instruction := Instruction{PC: pc, Op: vm.OpCode(code[pc])}
+		if instruction.Op.IsPush() && instruction.Op != vm.PUSH0 {
+			size := int(instruction.Op-vm.PUSH1) + 1
+			end := min(pc+1+size, len(code))
+			instruction.Operand = code[pc+1 : end]
+			pc += size
+		}
+		instructions = append(instructions, instruction)
+	}
+	return instructions
+}
+
+// ExtractSelectors returns the selectors the function dispatcher of the code compares the calldata
+// against, in ascending order. A selector is a PUSH1 to PUSH4 operand, left-padded as compilers trim
+// leading zero bytes, followed by an EQ or a XOR, possibly after DUP and SWAP instructions, whose
+// result drives a conditional jump.
+func ExtractSelectors(code []byte) []Selector {
+	instructions := Disassemble(code)
+	found := make(map[Selector]bool)
+	for idx, instruction := range instructions {
+		if instruction.Op < vm.PUSH1 || instruction.Op > vm.PUSH4 || len(instruction.Operand) == 0 {
+			continue
+		}
+		if !comparedThenJumped(instructions[idx+1:]) {
+			continue
+		}
+		var selector Selector
+		copy(selector[4-len(instruction.Operand):], instruction.Operand)
+		found[selector] = true
+	}
+
+	selectors := make([]Selector, 0, len(found))
+	for selector := range found {
+		selectors = append(selectors, selector)
+	}
+	slices.SortFunc(selectors, func(a, b Selector) int { return slices.Compare(a[:], b[:]) })
+	return selectors
+}
+
+// comparedThenJumped reports whether the instructions start with an EQ or a XOR, possibly after stack
+// manipulations, followed by a jump destination push and a JUMPI.
+func comparedThenJumped(instructions []Instruction) bool {
+	idx := 0
+	for idx < len(instructions) && isStackShuffle(instructions[idx].Op) {
+		idx++
+	}
+	if idx+2 >= len(instructions) {
+		return false
+	}
+	compare := instructions[idx].Op
+	return (compare == vm.EQ || compare == vm.XOR) &&
+		instructions[idx+1].Op.IsPush() &&
+		instructions[idx+2].Op == vm.JUMPI
+}
+
+// isStackShuffle reports whether op only duplicates or swaps stack items.
+func isStackShuffle(op vm.OpCode) bool {
+	return (op >= vm.DUP1 && op <= vm.DUP16) || (op >= vm.SWAP1 && op <= vm.SWAP16)
+}
@@ -0,0 +1,88 @@
+package hex_test
+
+import (
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Disassemble verifies that PUSH operands are skipped, including truncated ones.
+func Test_Disassemble(t *testing.T) {
+	instructions := hex.Disassemble(common.FromHex("0x6080604052345f7f0102"))
+	assert.Equal(t, []hex.Instruction{
+		{PC: 0, Op: vm.PUSH1, Operand: []byte{0x80}},
+		{PC: 2, Op: vm.PUSH1, Operand: []byte{0x40}},
+		{PC: 4, Op: vm.MSTORE},
+		{PC: 5, Op: vm.CALLVALUE},
+		{PC: 6, Op: vm.PUSH0},
+		{PC: 7, Op: vm.PUSH32, Operand: []byte{0x01, 0x02}},
+	}, instructions)
+	assert.Equal(t, "0x0007 PUSH32 0x0102", instructions[5].String())
+}
+
+// Test_StripMetadata verifies that only well-formed compiler trailers are removed.
+func Test_StripMetadata(t *testing.T) {
+	code := common.FromHex("0x6000f3fe")
+	trailer := common.FromHex("0xa264697066735822122074c3d91b00f03eabc612fdf40327d775b6d072b33b2e46d9e2aff848c5300ff4" +
+		"64736f6c634300081c0033")
+	short := common.FromHex("0x6000ffff")
+	notMap := common.FromHex("0x6000600060000004")
+
+	tests := []struct {
+		Name     string
+		Code     []byte
+		Expected []byte
+	}{
+		{Name: "OK - Solc trailer", Code: append(append([]byte{}, code...), trailer...), Expected: code},
+		{Name: "OK - No trailer", Code: code, Expected: code},
+		{Name: "OK - Length past the code", Code: short, Expected: short},
+		{Name: "OK - Not a CBOR map", Code: notMap, Expected: notMap},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, hex.StripMetadata(tt.Code))
+		})
+	}
+}
+
+// Test_ExtractSelectors verifies that the selectors compared by function dispatchers are extracted.
+func Test_ExtractSelectors(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Code     string
+		Expected []hex.Selector
+	}{
+		{
+			Name: "OK - Solc dispatcher",
+			Code: inferences.DisperseMetaData.Bin,
+			Expected: []hex.Selector{
+				hex.SelectorOf("disperseTokenSimple(address,address[],uint256[])"),
+				hex.SelectorOf("disperseToken(address,address[],uint256[])"),
+				hex.SelectorOf("disperseEther(address[],uint256[])"),
+			},
+		},
+		{
+			Name: "OK - Leading zero selector and via-IR ordering",
+			// PUSH3 fdd58e DUP2 EQ PUSH2 JUMPI, then PUSH4 0e89341c DUP2 EQ PUSH2 JUMPI
+			Code: "0x62fdd58e81146100115763" + "0e89341c" + "811461001157",
+			Expected: []hex.Selector{
+				{0x00, 0xfd, 0xd5, 0x8e},
+				{0x0e, 0x89, 0x34, 0x1c},
+			},
+		},
+		{
+			Name:     "OK - Selector in push data or not compared",
+			Code:     "0x7f6352211e" + "00000000000000000000000000000000000000000000000000000000" + "636352211e50",
+			Expected: []hex.Selector{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.ElementsMatch(t, tt.Expected, hex.ExtractSelectors(common.FromHex(tt.Code)))
+		})
+	}
+}
@@ -57,15 +57,7 @@ func GetImplementationAddress(
 	client simulated.Client,
 	proxyAddr common.Address,
 ) (common.Address, error) {
-	// EIP-1967 implementation slot
-	slot := common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
-
-	result, err := client.StorageAt(ctx, proxyAddr, slot, nil)
-	if err != nil {
-		return common.Address{}, err
-	}
-
-	return common.BytesToAddress(result), nil
+	return readAddressSlot(ctx, client, proxyAddr, EIP1967ImplementationSlot)
 }
 
 // CheckDiamondFunction checks if a function selector is supported by a Diamond proxy contract
@@ -0,0 +1,94 @@
+package hex
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// KnownInterface is an ERC165 interface and the functions it is made of.
+type KnownInterface struct {
+	Name      string
+	ID        [4]byte
+	Functions []string
+}
+
+// KnownInterfaces are the interfaces queried through ERC165 when detecting the capabilities of a contract.
+var KnownInterfaces = []KnownInterface{
+	{
+		Name: "ERC20",
+		ID:   IERC20InterfaceID,
+		Functions: []string{
+			"totalSupply()",
+			"balanceOf(address)",
+			"transfer(address,uint256)",
+			"transferFrom(address,address,uint256)",
+			"approve(address,uint256)",
+			"allowance(address,address)",
+		},
+	},
+	{
+		Name: "ERC721",
+		ID:   IERC721InterfaceID,
+		Functions: []string{
+			"balanceOf(address)",
+			"ownerOf(uint256)",
+			"safeTransferFrom(address,address,uint256,bytes)",
+			"safeTransferFrom(address,address,uint256)",
+			"transferFrom(address,address,uint256)",
+			"approve(address,uint256)",
+			"setApprovalForAll(address,bool)",
+			"getApproved(uint256)",
+			"isApprovedForAll(address,address)",
+		},
+	},
+	{
+		Name:      "ERC721Metadata",
+		ID:        IERC721MetadataInterfaceID,
+		Functions: []string{"name()", "symbol()", "tokenURI(uint256)"},
+	},
+	{
+		Name:      "ERC721Enumerable",
+		ID:        IERC721EnumerableInterfaceID,
+		Functions: []string{"totalSupply()", "tokenOfOwnerByIndex(address,uint256)", "tokenByIndex(uint256)"},
+	},
+	{
+		Name: "ERC1155",
+		ID:   IERC1155InterfaceID,
+		Functions: []string{
+			"safeTransferFrom(address,address,uint256,uint256,bytes)",
+			"safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)",
+			"balanceOf(address,uint256)",
+			"balanceOfBatch(address[],uint256[])",
+			"setApprovalForAll(address,bool)",
+			"isApprovedForAll(address,address)",
+		},
+	},
+	{
+		Name:      "ERC1155MetadataURI",
+		ID:        IERC1155MetadataURIInterfaceID,
+		Functions: []string{"uri(uint256)"},
+	},
+	{
+		Name:      "ERC2981",
+		ID:        IERC2981InterfaceID,
+		Functions: []string{"royaltyInfo(uint256,uint256)"},
+	},
+}
+
+// SelectorOf returns the selector of a function signature.
+func SelectorOf(signature string) Selector {
+	var selector Selector
+	copy(selector[:], crypto.Keccak256([]byte(signature)))
+	return selector
+}
+
+// InterfaceID returns the ERC165 interface ID of the functions, the XOR of their selectors.
+func InterfaceID(functions ...string) [4]byte {
+	var id [4]byte
+	for _, function := range functions {
+		selector := SelectorOf(function)
+		for idx := range id {
+			id[idx] ^= selector[idx]
+		}
+	}
+	return id
+}
@@ -12,6 +12,18 @@ var (
 	IERC20InterfaceID = [4]byte{0x36, 0x37, 0x2b, 0x07}
 	// IERC1155InterfaceID is the interface ID for ERC1155 tokens
 	IERC1155InterfaceID = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+	// IERC165InterfaceID is the interface ID of ERC165 interface detection
+	IERC165InterfaceID = [4]byte{0x01, 0xff, 0xc9, 0xa7}
+	// IERC721MetadataInterfaceID is the interface ID for the ERC721 metadata extension
+	IERC721MetadataInterfaceID = [4]byte{0x5b, 0x5e, 0x13, 0x9f}
+	// IERC721EnumerableInterfaceID is the interface ID for the ERC721 enumerable extension
+	IERC721EnumerableInterfaceID = [4]byte{0x78, 0x0e, 0x9d, 0x63}
+	// IERC1155MetadataURIInterfaceID is the interface ID for the ERC1155 metadata URI extension
+	IERC1155MetadataURIInterfaceID = [4]byte{0x0e, 0x89, 0x34, 0x1c}
+	// IERC2981InterfaceID is the interface ID for ERC2981 royalties
+	IERC2981InterfaceID = [4]byte{0x2a, 0x55, 0x20, 0x5a}
+	// InvalidInterfaceID is the interface ID ERC165 contracts must report as unsupported
+	InvalidInterfaceID = [4]byte{0xff, 0xff, 0xff, 0xff}
 )
 
 const (
@@ -37,6 +49,10 @@ var (
 var (
 	// ErrZeroAddress is returned when the zero address is used
 	ErrZeroAddress = errors.New("TransferToZeroAddress")
+	// ErrProxyCycle is returned when a proxy chain delegates back to one of its layers
+	ErrProxyCycle = errors.New("proxy chain delegates back to one of its layers")
+	// ErrProxyTooDeep is returned when a proxy chain has more than MaxProxyDepth layers
+	ErrProxyTooDeep = errors.New("proxy chain exceeds the maximum depth")
 )
 
 // ParseEther converts a big.Int wei value to a float64 ether value
@@ -63,4 +79,29 @@ const diamondLoupeABI = `[{
     }
     ],
     "stateMutability": "view"
+},
+{
+    "type": "function",
+    "name": "facets",
+    "inputs": [],
+    "outputs": [
+    {
+        "name": "facets_",
+        "type": "tuple[]",
+        "internalType": "struct IDiamondLoupe.Facet[]",
+        "components": [
+        {
+            "name": "facetAddress",
+            "type": "address",
+            "internalType": "address"
+        },
+        {
+            "name": "functionSelectors",
+            "type": "bytes4[]",
+            "internalType": "bytes4[]"
+        }
+        ]
+    }
+    ],
+    "stateMutability": "view"
 }]`
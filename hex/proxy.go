@@ -0,0 +1,249 @@
+package hex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+// ProxyKind is the pattern through which a contract forwards calls to another contract.
+type ProxyKind string
+
+const (
+	// NotProxy means the contract runs its own code.
+	NotProxy ProxyKind = ""
+	// EIP1967Proxy delegates to the implementation stored in the EIP-1967 implementation slot.
+	EIP1967Proxy ProxyKind = "eip1967"
+	// BeaconProxy delegates to the implementation returned by the beacon stored in the EIP-1967 beacon slot.
+	BeaconProxy ProxyKind = "eip1967-beacon"
+	// MinimalProxy is an EIP-1167 clone, whose code embeds the implementation address.
+	MinimalProxy ProxyKind = "eip1167"
+	// LegacyOZProxy delegates to the implementation stored in the slot of the OpenZeppelin (zos) proxies
+	// preceding EIP-1967.
+	LegacyOZProxy ProxyKind = "oz-legacy"
+	// GnosisSafeProxy delegates to the master copy its masterCopy function returns.
+	GnosisSafeProxy ProxyKind = "gnosis-safe"
+	// DiamondProxy is an EIP-2535 diamond, routing each selector to one of its facets.
+	DiamondProxy ProxyKind = "eip2535"
+)
+
+// MaxProxyDepth is the maximum number of layers ResolveProxy follows.
+const MaxProxyDepth = 10
+
+var (
+	// EIP1967ImplementationSlot is bytes32(uint256(keccak256("eip1967.proxy.implementation")) - 1)
+	EIP1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// EIP1967BeaconSlot is bytes32(uint256(keccak256("eip1967.proxy.beacon")) - 1)
+	EIP1967BeaconSlot = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+	// LegacyOZImplementationSlot is keccak256("org.zeppelinos.proxy.implementation")
+	LegacyOZImplementationSlot = common.HexToHash("0x7050c9e0f4ca769c69bd3a8ef740bc37934f8e2c036e5a723fd8ee048ed3f8c3")
+)
+
+var (
+	// minimalProxyPrefix and minimalProxySuffix surround the implementation address in EIP-1167 clones.
+	minimalProxyPrefix = common.FromHex("0x363d3d373d3d3d363d73")
+	minimalProxySuffix = common.FromHex("0x5af43d82803e903d91602b57fd5bf3")
+)
+
+// Facet is a contract an EIP-2535 diamond routes selectors to.
+type Facet struct {
+	Address   common.Address
+	Selectors []Selector
+}
+
+// loupeFacet mirrors the IDiamondLoupe.Facet struct returned by facets.
+type loupeFacet struct {
+	FacetAddress      common.Address
+	FunctionSelectors [][4]byte
+}
+
+// ProxyLayer is a contract of a proxy chain.
+type ProxyLayer struct {
+	Address common.Address
+	// Kind is how the contract forwards calls to the next layer, NotProxy for the last layer.
+	Kind ProxyKind
+	// Beacon is the beacon the implementation was read from, for beacon proxies.
+	Beacon common.Address
+	// Facets holds the facets of a diamond, which ends the chain.
+	Facets []Facet
+}
+
+// ResolveProxy follows the proxies from the contract down to the contract running the code. The chain
+// starts with the contract itself and ends with a layer that is not a proxy, or with a diamond.
+func ResolveProxy(ctx context.Context, client simulated.Client, contractAddr common.Address) ([]ProxyLayer, error) {
+	var chain []ProxyLayer
+	address := contractAddr
+	for {
+		if len(chain) == MaxProxyDepth {
+			return nil, ErrProxyTooDeep
+		}
+		if slices.ContainsFunc(chain, func(layer ProxyLayer) bool { return layer.Address == address }) {
+			return nil, fmt.Errorf("%w: %s", ErrProxyCycle, address)
+		}
+
+		layer, next, err := resolveLayer(ctx, client, address)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, layer)
+		if layer.Kind == NotProxy || layer.Kind == DiamondProxy {
+			return chain, nil
+		}
+		address = next
+	}
+}
+
+// resolveLayer identifies the proxy pattern of the contract and returns the address it forwards to.
+// Storage slots are checked before calls, which proxies would forward to their implementation.
+func resolveLayer(
+	ctx context.Context,
+	client simulated.Client,
+	address common.Address,
+) (ProxyLayer, common.Address, error) {
+	layer := ProxyLayer{Address: address}
+
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return layer, common.Address{}, fmt.Errorf("failed to get contract bytecode: %w", err)
+	}
+	if len(code) == len(minimalProxyPrefix)+common.AddressLength+len(minimalProxySuffix) &&
+		bytes.HasPrefix(code, minimalProxyPrefix) && bytes.HasSuffix(code, minimalProxySuffix) {
+		layer.Kind = MinimalProxy
+		return layer, common.BytesToAddress(code[len(minimalProxyPrefix) : len(code)-len(minimalProxySuffix)]), nil
+	}
+
+	implementation, err := readAddressSlot(ctx, client, address, EIP1967ImplementationSlot)
+	if err != nil || implementation != (common.Address{}) {
+		layer.Kind = EIP1967Proxy
+		return layer, implementation, err
+	}
+
+	beacon, err := readAddressSlot(ctx, client, address, EIP1967BeaconSlot)
+	if err != nil {
+		return layer, common.Address{}, err
+	}
+	if beacon != (common.Address{}) {
+		implementation, ok, err := callAddress(ctx, client, beacon, "implementation()")
+		if err != nil {
+			return layer, common.Address{}, err
+		}
+		if !ok {
+			return layer, common.Address{}, fmt.Errorf("beacon %s returned no implementation", beacon)
+		}
+		layer.Kind = BeaconProxy
+		layer.Beacon = beacon
+		return layer, implementation, nil
+	}
+
+	implementation, err = readAddressSlot(ctx, client, address, LegacyOZImplementationSlot)
+	if err != nil || implementation != (common.Address{}) {
+		layer.Kind = LegacyOZProxy
+		return layer, implementation, err
+	}
+
+	masterCopy, ok, err := callAddress(ctx, client, address, "masterCopy()")
+	if err != nil {
+		return layer, common.Address{}, err
+	}
+	if ok {
+		// Contracts answering any call with a word must not pass for Safe proxies.
+		masterCode, err := client.CodeAt(ctx, masterCopy, nil)
+		if err != nil {
+			return layer, common.Address{}, fmt.Errorf("failed to get master copy bytecode: %w", err)
+		}
+		if len(masterCode) > 0 {
+			layer.Kind = GnosisSafeProxy
+			return layer, masterCopy, nil
+		}
+	}
+
+	facets, err := DiamondFacets(ctx, client, address)
+	if err != nil {
+		return layer, common.Address{}, err
+	}
+	if len(facets) > 0 {
+		layer.Kind = DiamondProxy
+		layer.Facets = facets
+	}
+	return layer, common.Address{}, nil
+}
+
+// DiamondFacets enumerates the facets of an EIP-2535 diamond through its loupe facets function.
+// Contracts that are not diamonds have no facets.
+func DiamondFacets(ctx context.Context, client simulated.Client, diamondAddr common.Address) ([]Facet, error) {
+	loupeABI, err := abi.JSON(strings.NewReader(diamondLoupeABI))
+	if err != nil {
+		return nil, err
+	}
+	data, err := loupeABI.Pack("facets")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode facets call: %w", err)
+	}
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &diamondAddr, Data: data}, nil)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, nil
+	}
+	values, err := loupeABI.Unpack("facets", result)
+	if err != nil || len(values) != 1 {
+		return nil, nil
+	}
+	decoded := *abi.ConvertType(values[0], new([]loupeFacet)).(*[]loupeFacet)
+
+	facets := make([]Facet, 0, len(decoded))
+	for _, facet := range decoded {
+		selectors := make([]Selector, len(facet.FunctionSelectors))
+		for idx, selector := range facet.FunctionSelectors {
+			selectors[idx] = selector
+		}
+		facets = append(facets, Facet{Address: facet.FacetAddress, Selectors: selectors})
+	}
+	return facets, nil
+}
+
+// readAddressSlot reads an address stored right-aligned in a storage slot.
+func readAddressSlot(
+	ctx context.Context,
+	client simulated.Client,
+	address common.Address,
+	slot common.Hash,
+) (common.Address, error) {
+	result, err := client.StorageAt(ctx, address, slot, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(result), nil
+}
+
+// callAddress calls a function without arguments returning an address. Calls that revert or do not
+// return a single non-zero address report no address.
+func callAddress(
+	ctx context.Context,
+	client simulated.Client,
+	address common.Address,
+	signature string,
+) (common.Address, bool, error) {
+	selector := SelectorOf(signature)
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &address, Data: selector[:]}, nil)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return common.Address{}, false, ctxErr
+		}
+		return common.Address{}, false, nil
+	}
+	if len(result) != common.HashLength || !bytes.Equal(result[:common.HashLength-common.AddressLength],
+		make([]byte, common.HashLength-common.AddressLength)) {
+		return common.Address{}, false, nil
+	}
+	implementation := common.BytesToAddress(result)
+	return implementation, implementation != (common.Address{}), nil
+}
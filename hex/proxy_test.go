@@ -0,0 +1,182 @@
+package hex_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+// deployRuntime deploys the runtime code with the given storage slots set.
+func deployRuntime(
+	t *testing.T,
+	auth *bind.TransactOpts,
+	backend *simulated.Backend,
+	runtime []byte,
+	storage map[common.Hash]common.Address,
+) common.Address {
+	address, err := testingtools.DeployRuntime(auth, backend, runtime, storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *address
+}
+
+// Test_ResolveProxy verifies the chains resolved for each proxy pattern.
+func Test_ResolveProxy(t *testing.T) {
+	backend, auth, nftAddr, _, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	stop := []byte{0x00}
+	clone := deployRuntime(t, auth, backend, common.FromHex("0x363d3d373d3d3d363d73"+
+		nftAddr.Hex()[2:]+"5af43d82803e903d91602b57fd5bf3"), nil)
+	// The EIP-1967 proxy forwards the calldata to the implementation in its slot and bubbles the result.
+	eip1967 := deployRuntime(t, auth, backend, common.FromHex("0x365f5f375f5f365f7f"+
+		hex.EIP1967ImplementationSlot.Hex()[2:]+"545af43d5f5f3e6036573d5ffd5b3d5ff3"),
+		map[common.Hash]common.Address{hex.EIP1967ImplementationSlot: clone})
+	// The beacon answers any call with the address of the ERC721 contract.
+	beacon := deployRuntime(t, auth, backend, testingtools.ReturningCode(0, common.LeftPadBytes(nftAddr.Bytes(), 32)), nil)
+	beaconProxy := deployRuntime(t, auth, backend, stop, map[common.Hash]common.Address{hex.EIP1967BeaconSlot: beacon})
+	legacy := deployRuntime(t, auth, backend, stop,
+		map[common.Hash]common.Address{hex.LegacyOZImplementationSlot: *nftAddr})
+	// Like the beacon, a contract answering masterCopy with the ERC721 contract.
+	safe := beacon
+
+	facets := []hex.Facet{
+		{
+			Address:   *nftAddr,
+			Selectors: []hex.Selector{hex.SelectorOf("ownerOf(uint256)"), hex.SelectorOf("totalSupply()")},
+		},
+		{Address: common.HexToAddress("0x3001"), Selectors: []hex.Selector{hex.SelectorOf("uri(uint256)")}},
+	}
+	diamond := deployRuntime(t, auth, backend, testingtools.ReturningCode(0, encodeFacets(t, facets)), nil)
+
+	nonce, err := backend.Client().PendingNonceAt(context.Background(), auth.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loop := deployRuntime(t, auth, backend, stop,
+		map[common.Hash]common.Address{hex.LegacyOZImplementationSlot: crypto.CreateAddress(auth.From, nonce)})
+
+	tests := []struct {
+		Name          string
+		Contract      common.Address
+		Expected      []hex.ProxyLayer
+		ExpectError   bool
+		ExpectedError error
+	}{
+		{
+			Name:     "OK - Not a proxy",
+			Contract: *nftAddr,
+			Expected: []hex.ProxyLayer{{Address: *nftAddr}},
+		},
+		{
+			Name:     "OK - EIP-1967 proxy of a clone",
+			Contract: eip1967,
+			Expected: []hex.ProxyLayer{
+				{Address: eip1967, Kind: hex.EIP1967Proxy},
+				{Address: clone, Kind: hex.MinimalProxy},
+				{Address: *nftAddr},
+			},
+		},
+		{
+			Name:     "OK - Beacon proxy",
+			Contract: beaconProxy,
+			Expected: []hex.ProxyLayer{
+				{Address: beaconProxy, Kind: hex.BeaconProxy, Beacon: beacon},
+				{Address: *nftAddr},
+			},
+		},
+		{
+			Name:     "OK - Legacy OpenZeppelin proxy",
+			Contract: legacy,
+			Expected: []hex.ProxyLayer{{Address: legacy, Kind: hex.LegacyOZProxy}, {Address: *nftAddr}},
+		},
+		{
+			Name:     "OK - Gnosis Safe proxy",
+			Contract: safe,
+			Expected: []hex.ProxyLayer{{Address: safe, Kind: hex.GnosisSafeProxy}, {Address: *nftAddr}},
+		},
+		{
+			Name:     "OK - Diamond",
+			Contract: diamond,
+			Expected: []hex.ProxyLayer{{Address: diamond, Kind: hex.DiamondProxy, Facets: facets}},
+		},
+		{
+			Name:          "NOK - Proxy delegating to itself",
+			Contract:      loop,
+			ExpectError:   true,
+			ExpectedError: hex.ErrProxyCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			chain, err := hex.ResolveProxy(context.Background(), backend.Client(), tt.Contract)
+			if tt.ExpectError {
+				assert.True(t, errors.Is(err, tt.ExpectedError))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.Expected, chain)
+		})
+	}
+
+	// Calls through the proxy and the clone run the ERC721 code, against the proxy's storage.
+	data, err := hex.GetEncodedFunction(inferences.Ierc721MetaData.ABI, "supportsInterface", hex.IERC721InterfaceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := backend.Client().CallContract(context.Background(), ethereum.CallMsg{To: &eip1967, Data: data}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, common.BigToHash(common.Big1).Bytes(), result)
+}
+
+// encodeFacets encodes the facets as returned by the diamond loupe facets function.
+func encodeFacets(t *testing.T, facets []hex.Facet) []byte {
+	arguments, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"facets","inputs":[],"outputs":[` +
+		`{"name":"","type":"tuple[]","components":[{"name":"facetAddress","type":"address"},` +
+		`{"name":"functionSelectors","type":"bytes4[]"}]}]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type facet struct {
+		FacetAddress      common.Address
+		FunctionSelectors [][4]byte
+	}
+	encoded := make([]facet, len(facets))
+	for idx, f := range facets {
+		encoded[idx].FacetAddress = f.Address
+		for _, selector := range f.Selectors {
+			encoded[idx].FunctionSelectors = append(encoded[idx].FunctionSelectors, selector)
+		}
+	}
+	data, err := arguments.Methods["facets"].Outputs.Pack(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
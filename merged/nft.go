@@ -3,14 +3,17 @@
 package merged
 
 import (
+	"context"
 	"math/big"
 
+	"github.com/Thektonic/eth-interfaces/base"
 	"github.com/Thektonic/eth-interfaces/hex"
 	"github.com/Thektonic/eth-interfaces/inferences"
 	"github.com/Thektonic/eth-interfaces/models"
 	"github.com/Thektonic/eth-interfaces/nft"
 	"github.com/Thektonic/eth-interfaces/nft/enumerable"
 	"github.com/Thektonic/eth-interfaces/nft/royalties"
+	"github.com/Thektonic/eth-interfaces/transaction"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -20,6 +23,8 @@ type IERC721SummedInteractions struct {
 	*nft.ERC721Interactions
 	*royalties.IERC721RoyaltiesInteractions
 	*enumerable.ERC721EnumerableInteractions
+	erc721    *inferences.Ierc721
+	callError func(string, error) error
 }
 
 // ExtensionEnum denotes the types of NFT interaction extensions to be included in the summed interactions.
@@ -69,13 +74,26 @@ func NewERC721SummedInteractions(
 		}
 	}
 
-	return &IERC721SummedInteractions{baseIERC721, roy, enum}, nil
+	erc721 := inferences.NewIerc721()
+	callError := base.GenCallError("erc721", nft.ParseError, erc721.UnpackError)
+
+	return &IERC721SummedInteractions{baseIERC721, roy, enum, erc721, callError}, nil
 }
 
 // AllInfos retrieves combined information for a given token, including base metadata,
 // total supply, and royalty information.
 func (s *IERC721SummedInteractions) AllInfos(
 	tokenIDs ...*big.Int,
+) (*models.TokenMeta, *big.Int, *inferences.RoyaltyInfoOutput, error) {
+	return s.AllInfosCtx(s.ERC721Interactions.Ctx, tokenIDs...)
+}
+
+// AllInfosCtx retrieves combined information for a given token like AllInfos, using ctx.
+// All the reads are aggregated in a single call when multicall batching is enabled, and share a
+// JSON-RPC batch when RPC batching is.
+func (s *IERC721SummedInteractions) AllInfosCtx(
+	ctx context.Context,
+	tokenIDs ...*big.Int,
 ) (*models.TokenMeta, *big.Int, *inferences.RoyaltyInfoOutput, error) {
 	var tokenID *big.Int
 	if len(tokenIDs) == 0 {
@@ -84,20 +102,73 @@ func (s *IERC721SummedInteractions) AllInfos(
 		tokenID = tokenIDs[0]
 	}
 
-	baseInfos, err := s.TokenMetaInfos(tokenID)
-	if err != nil {
-		return nil, nil, nil, err
+	if multicall := s.NewMulticall(s.GetSession().CallOpts()); multicall != nil {
+		return s.batchAllInfos(ctx, multicall, tokenID)
 	}
 
-	supply, err := s.TotalSupply()
-	if err != nil {
-		return baseInfos, nil, nil, err
+	var baseInfos *models.TokenMeta
+	var supply *big.Int
+	var royalties inferences.RoyaltyInfoOutput
+	var infosErr, supplyErr, royaltiesErr error
+	s.RunReads(
+		func() { baseInfos, infosErr = s.TokenMetaInfosCtx(ctx, tokenID) },
+		func() { supply, supplyErr = s.TotalSupplyCtx(ctx) },
+		func() { royalties, royaltiesErr = s.RoyaltiesInfosCtx(ctx, tokenID, big.NewInt(1)) },
+	)
+	if infosErr != nil {
+		return nil, nil, nil, infosErr
+	}
+	if supplyErr != nil {
+		return baseInfos, nil, nil, supplyErr
+	}
+	if royaltiesErr != nil {
+		return baseInfos, supply, nil, royaltiesErr
 	}
 
-	royalties, err := s.RoyaltiesInfos(tokenID, big.NewInt(1))
+	return baseInfos, supply, &royalties, nil
+}
+
+// batchAllInfos retrieves the metadata, total supply and royalty information of a token through multicall.
+func (s *IERC721SummedInteractions) batchAllInfos(
+	ctx context.Context,
+	multicall *transaction.Multicall,
+	tokenID *big.Int,
+) (*models.TokenMeta, *big.Int, *inferences.RoyaltyInfoOutput, error) {
+	address := s.GetAddress()
+	name := transaction.AddCall(multicall, address, s.erc721.PackName(), s.erc721.UnpackName, true)
+	symbol := transaction.AddCall(multicall, address, s.erc721.PackSymbol(), s.erc721.UnpackSymbol, true)
+	uri := transaction.AddCall(multicall, address, s.erc721.PackTokenURI(tokenID), s.erc721.UnpackTokenURI, true)
+	supply := transaction.AddCall(multicall, address, s.erc721.PackTotalSupply(), s.erc721.UnpackTotalSupply, true)
+	royalties := transaction.AddCall(multicall,
+		address,
+		s.erc721.PackRoyaltyInfo(tokenID, big.NewInt(1)),
+		s.erc721.UnpackRoyaltyInfo,
+		true,
+	)
+	if err := multicall.Execute(ctx); err != nil {
+		return nil, nil, nil, s.callError("AllInfos()", err)
+	}
+
+	baseInfos := &models.TokenMeta{}
+	var err error
+	if baseInfos.Name, err = name.Get(); err != nil {
+		return nil, nil, nil, s.callError("Name()", err)
+	}
+	if baseInfos.Symbol, err = symbol.Get(); err != nil {
+		return &models.TokenMeta{Name: baseInfos.Name}, nil, nil, s.callError("Symbol()", err)
+	}
+	if baseInfos.URI, err = uri.Get(); err != nil {
+		return baseInfos, nil, nil, s.callError("TokenURI()", err)
+	}
+
+	totalSupply, err := supply.Get()
 	if err != nil {
-		return baseInfos, supply, nil, err
+		return baseInfos, nil, nil, s.callError("TotalSupply()", err)
 	}
 
-	return baseInfos, supply, &royalties, nil
+	royaltyInfo, err := royalties.Get()
+	if err != nil {
+		return baseInfos, totalSupply, nil, s.callError("RoyaltyInfo()", err)
+	}
+	return baseInfos, totalSupply, &royaltyInfo, nil
 }
@@ -1,6 +1,7 @@
 package merged_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/Thektonic/eth-interfaces/base"
@@ -96,3 +97,50 @@ func Test_Instantiation(t *testing.T) {
 		})
 	}
 }
+
+// Test_AllInfosMulticall verifies that AllInfos returns the same information when batched through Multicall3.
+func Test_AllInfosMulticall(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	multicallAddr, err := testingtools.DeployMulticall3(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{})
+	assert.Nil(t, err)
+
+	summed, err := merged.NewERC721SummedInteractions(nftA,
+		[]hex.Signature{royalties.RoyaltyInfo},
+		merged.Royalties,
+		merged.Enumerable,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, supply, royaltyInfo, err := summed.AllInfos(big.NewInt(1))
+	assert.Nil(t, err)
+
+	baseInteractions.SetMulticall(*multicallAddr, 0)
+
+	batchedMeta, batchedSupply, batchedRoyaltyInfo, err := summed.AllInfos(big.NewInt(1))
+	assert.Nil(t, err)
+	assert.Equal(t, meta, batchedMeta)
+	assert.Equal(t, supply, batchedSupply)
+	assert.Equal(t, royaltyInfo, batchedRoyaltyInfo)
+}
@@ -2,6 +2,7 @@
 package nft
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -61,7 +62,7 @@ func NewERC721Interactions(
 	erc721ASession := session{
 		erc721:   erc721,
 		callOpts: &bind.CallOpts{Pending: true, From: baseInteractions.Address},
-		instance: erc721.Instance(baseInteractions.Client, address),
+		instance: erc721.Instance(baseInteractions.Backend(), address),
 	}
 
 	callError := base.GenCallError("erc721", ParseError, erc721.UnpackError)
@@ -93,9 +94,56 @@ func (d *ERC721Interactions) GetSession() transaction.Session {
 	return d.session
 }
 
+// AtBlock returns a read-only copy of the interactions whose calls are pinned to the given block.
+// A nil block number stands for the latest block, as in go-ethereum, and pins nothing.
+func (d *ERC721Interactions) AtBlock(blockNumber *big.Int) *ERC721Interactions {
+	callOpts := &bind.CallOpts{From: d.Address}
+	if blockNumber != nil {
+		callOpts.BlockNumber = new(big.Int).Set(blockNumber)
+	}
+	return d.withCallOpts(callOpts, true)
+}
+
+// AtBlockHash returns a read-only copy of the interactions whose calls are pinned to the given block hash.
+func (d *ERC721Interactions) AtBlockHash(blockHash common.Hash) *ERC721Interactions {
+	return d.withCallOpts(&bind.CallOpts{From: d.Address, BlockHash: blockHash}, true)
+}
+
+// Latest returns a copy of the interactions whose calls run against the latest block.
+// Copies of read-only views stay read-only.
+func (d *ERC721Interactions) Latest() *ERC721Interactions {
+	return d.withCallOpts(&bind.CallOpts{From: d.Address}, false)
+}
+
+// Pending returns a copy of the interactions whose calls run against the pending state, the default.
+// Copies of read-only views stay read-only.
+func (d *ERC721Interactions) Pending() *ERC721Interactions {
+	return d.withCallOpts(&bind.CallOpts{Pending: true, From: d.Address}, false)
+}
+
+// withCallOpts returns a copy of the interactions calling with callOpts, read-only if requested.
+func (d *ERC721Interactions) withCallOpts(callOpts *bind.CallOpts, readOnly bool) *ERC721Interactions {
+	view := *d
+	view.session = &session{
+		erc721:   d.erc721,
+		callOpts: callOpts,
+		instance: d.instance,
+	}
+	if readOnly {
+		view.Interactions = d.Interactions.ReadOnly()
+	}
+	return &view
+}
+
 // GetBalance retrieves the balance of NFTs for the associated address.
 func (d *ERC721Interactions) GetBalance() (*big.Int, error) {
-	balance, err := transaction.Call(
+	return d.GetBalanceCtx(d.Ctx)
+}
+
+// GetBalanceCtx retrieves the balance of NFTs for the associated address using ctx.
+func (d *ERC721Interactions) GetBalanceCtx(ctx context.Context) (*big.Int, error) {
+	balance, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc721.PackBalanceOf(d.Address),
 		d.erc721.UnpackBalanceOf,
@@ -108,7 +156,17 @@ func (d *ERC721Interactions) GetBalance() (*big.Int, error) {
 
 // TransferTo transfers a specific token to another address after verifying ownership.
 func (d *ERC721Interactions) TransferTo(to common.Address, tokenID *big.Int) (*types.Transaction, error) {
-	tx, err := transaction.Transact(
+	return d.TransferToCtx(d.Ctx, to, tokenID)
+}
+
+// TransferToCtx transfers a specific token to another address using ctx.
+func (d *ERC721Interactions) TransferToCtx(
+	ctx context.Context,
+	to common.Address,
+	tokenID *big.Int,
+) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
 		d,
 		d.session,
 		d.erc721.PackSafeTransferFrom(d.Address, to, tokenID),
@@ -122,18 +180,29 @@ func (d *ERC721Interactions) TransferTo(to common.Address, tokenID *big.Int) (*t
 
 // TransferFirstOwnedTo transfers the first token owned by the signer to the specified address.
 func (d *ERC721Interactions) TransferFirstOwnedTo(to common.Address) (*types.Transaction, error) {
-	maxSupply, err := d.TotalSupply()
+	return d.TransferFirstOwnedToCtx(d.Ctx, to)
+}
+
+// TransferFirstOwnedToCtx transfers the first token owned by the signer to the specified address using ctx.
+func (d *ERC721Interactions) TransferFirstOwnedToCtx(
+	ctx context.Context,
+	to common.Address,
+) (*types.Transaction, error) {
+	maxSupply, err := d.TotalSupplyCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get total supply: %w", err)
 	}
 
 	for idx := range maxSupply.Int64() {
 		tokenID := big.NewInt(idx)
-		tx, err := d.TransferTo(to, tokenID)
+		tx, err := d.TransferToCtx(ctx, to, tokenID)
 		if err != nil {
 			if strings.Contains(err.Error(), hex.ErrZeroAddress.Error()) {
 				return nil, err
 			}
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
 			continue
 		}
 		return tx, nil
@@ -144,7 +213,13 @@ func (d *ERC721Interactions) TransferFirstOwnedTo(to common.Address) (*types.Tra
 
 // TotalSupply returns the total number of NFTs minted.
 func (d *ERC721Interactions) TotalSupply() (*big.Int, error) {
-	supply, err := transaction.Call(
+	return d.TotalSupplyCtx(d.Ctx)
+}
+
+// TotalSupplyCtx returns the total number of NFTs minted using ctx.
+func (d *ERC721Interactions) TotalSupplyCtx(ctx context.Context) (*big.Int, error) {
+	supply, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc721.PackTotalSupply(),
 		d.erc721.UnpackTotalSupply,
@@ -157,7 +232,13 @@ func (d *ERC721Interactions) TotalSupply() (*big.Int, error) {
 
 // BalanceOf retrieves the NFT balance for a given owner.
 func (d *ERC721Interactions) BalanceOf(owner common.Address) (*big.Int, error) {
-	balance, err := transaction.Call(
+	return d.BalanceOfCtx(d.Ctx, owner)
+}
+
+// BalanceOfCtx retrieves the NFT balance for a given owner using ctx.
+func (d *ERC721Interactions) BalanceOfCtx(ctx context.Context, owner common.Address) (*big.Int, error) {
+	balance, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc721.PackBalanceOf(owner),
 		d.erc721.UnpackBalanceOf,
@@ -170,7 +251,13 @@ func (d *ERC721Interactions) BalanceOf(owner common.Address) (*big.Int, error) {
 
 // OwnerOf retrieves the owner of a specific token.
 func (d *ERC721Interactions) OwnerOf(tokenID *big.Int) (common.Address, error) {
-	owner, err := transaction.Call(
+	return d.OwnerOfCtx(d.Ctx, tokenID)
+}
+
+// OwnerOfCtx retrieves the owner of a specific token using ctx.
+func (d *ERC721Interactions) OwnerOfCtx(ctx context.Context, tokenID *big.Int) (common.Address, error) {
+	owner, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc721.PackOwnerOf(tokenID),
 		d.erc721.UnpackOwnerOf,
@@ -183,7 +270,17 @@ func (d *ERC721Interactions) OwnerOf(tokenID *big.Int) (common.Address, error) {
 
 // Approve approves an address to transfer a specific token.
 func (d *ERC721Interactions) Approve(to common.Address, tokenID *big.Int) (*types.Transaction, error) {
-	tx, err := transaction.Transact(
+	return d.ApproveCtx(d.Ctx, to, tokenID)
+}
+
+// ApproveCtx approves an address to transfer a specific token using ctx.
+func (d *ERC721Interactions) ApproveCtx(
+	ctx context.Context,
+	to common.Address,
+	tokenID *big.Int,
+) (*types.Transaction, error) {
+	tx, err := transaction.TransactCtx(
+		ctx,
 		d,
 		d.session,
 		d.erc721.PackApprove(to, tokenID),
@@ -197,18 +294,27 @@ func (d *ERC721Interactions) Approve(to common.Address, tokenID *big.Int) (*type
 
 // TokenMetaInfos retrieves metadata about the specified token such as name, symbol, and URI.
 func (d *ERC721Interactions) TokenMetaInfos(tokenID *big.Int) (*models.TokenMeta, error) {
-	name, err := d.Name()
-	if err != nil {
-		return nil, err
+	return d.TokenMetaInfosCtx(d.Ctx, tokenID)
+}
+
+// TokenMetaInfosCtx retrieves the name, symbol and URI of the specified token using ctx.
+// The reads share a JSON-RPC batch when RPC batching is enabled.
+func (d *ERC721Interactions) TokenMetaInfosCtx(ctx context.Context, tokenID *big.Int) (*models.TokenMeta, error) {
+	var name, symbol, uri string
+	var nameErr, symbolErr, uriErr error
+	d.RunReads(
+		func() { name, nameErr = d.NameCtx(ctx) },
+		func() { symbol, symbolErr = d.SymbolCtx(ctx) },
+		func() { uri, uriErr = d.TokenURICtx(ctx, tokenID) },
+	)
+	if nameErr != nil {
+		return nil, nameErr
 	}
-	symbol, err := d.Symbol()
-	if err != nil {
-		return &models.TokenMeta{Name: name}, err
+	if symbolErr != nil {
+		return &models.TokenMeta{Name: name}, symbolErr
 	}
-
-	uri, err := d.TokenURI(tokenID)
-	if err != nil {
-		return &models.TokenMeta{Name: name, Symbol: symbol}, err
+	if uriErr != nil {
+		return &models.TokenMeta{Name: name, Symbol: symbol}, uriErr
 	}
 
 	return &models.TokenMeta{Name: name, Symbol: symbol, URI: uri}, nil
@@ -216,7 +322,13 @@ func (d *ERC721Interactions) TokenMetaInfos(tokenID *big.Int) (*models.TokenMeta
 
 // Name returns the name of the NFT.
 func (d *ERC721Interactions) Name() (string, error) {
-	name, err := transaction.Call(
+	return d.NameCtx(d.Ctx)
+}
+
+// NameCtx returns the name of the NFT using ctx.
+func (d *ERC721Interactions) NameCtx(ctx context.Context) (string, error) {
+	name, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc721.PackName(),
 		d.erc721.UnpackName,
@@ -230,7 +342,13 @@ func (d *ERC721Interactions) Name() (string, error) {
 
 // Symbol returns the symbol of the NFT.
 func (d *ERC721Interactions) Symbol() (string, error) {
-	symbol, err := transaction.Call(
+	return d.SymbolCtx(d.Ctx)
+}
+
+// SymbolCtx returns the symbol of the NFT using ctx.
+func (d *ERC721Interactions) SymbolCtx(ctx context.Context) (string, error) {
+	symbol, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc721.PackSymbol(),
 		d.erc721.UnpackSymbol,
@@ -244,7 +362,13 @@ func (d *ERC721Interactions) Symbol() (string, error) {
 
 // TokenURI returns the URI of the NFT.
 func (d *ERC721Interactions) TokenURI(tokenID *big.Int) (string, error) {
-	uri, err := transaction.Call(
+	return d.TokenURICtx(d.Ctx, tokenID)
+}
+
+// TokenURICtx returns the URI of the NFT using ctx.
+func (d *ERC721Interactions) TokenURICtx(ctx context.Context, tokenID *big.Int) (string, error) {
+	uri, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc721.PackTokenURI(tokenID),
 		d.erc721.UnpackTokenURI,
@@ -258,7 +382,13 @@ func (d *ERC721Interactions) TokenURI(tokenID *big.Int) (string, error) {
 
 // GetApproved returns the approved address for a specific token.
 func (d *ERC721Interactions) GetApproved(tokenID *big.Int) (common.Address, error) {
-	approved, err := transaction.Call(
+	return d.GetApprovedCtx(d.Ctx, tokenID)
+}
+
+// GetApprovedCtx returns the approved address for a specific token using ctx.
+func (d *ERC721Interactions) GetApprovedCtx(ctx context.Context, tokenID *big.Int) (common.Address, error) {
+	approved, err := transaction.CallCtx(
+		ctx,
 		d.session,
 		d.erc721.PackGetApproved(tokenID),
 		d.erc721.UnpackGetApproved,
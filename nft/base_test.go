@@ -429,6 +429,40 @@ func Test_GetBalance(t *testing.T) {
 	assert.Equal(t, balance.Uint64(), uint64(30))
 }
 
+// Test_ContextPropagation verifies that the context-taking variants run with the given context.
+func Test_ContextPropagation(t *testing.T) {
+	backend, _, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	base := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	nft, err := nft.NewERC721Interactions(base, *contractAddress, []nft.BaseNFTSignature{nft.TokenURI})
+	assert.Nil(t, err)
+
+	_, err = nft.TokenURICtx(context.Background(), big.NewInt(1))
+	assert.Nil(t, err)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = nft.TokenURICtx(canceled, big.NewInt(1))
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = nft.ApproveCtx(canceled, common.HexToAddress("0x1234"), big.NewInt(1))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 // Test_TransferFirstOwnedTo tests transferring the first owned token to a specified address.
 func Test_TransferFirstOwnedTo(t *testing.T) {
 	backend, auth, contractAddress, privKey, err := testingtools.SetupBlockchain(t,
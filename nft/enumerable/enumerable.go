@@ -2,6 +2,7 @@
 package enumerable
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/Thektonic/eth-interfaces/base"
@@ -42,15 +43,56 @@ func NewERC721EnumerableInteractions(
 	return &ERC721EnumerableInteractions{baseIERC721, ierc721Enumerable, callError}, nil
 }
 
+// AtBlock returns a read-only copy of the enumerable interactions whose calls are pinned to the given block.
+func (e *ERC721EnumerableInteractions) AtBlock(blockNumber *big.Int) *ERC721EnumerableInteractions {
+	return &ERC721EnumerableInteractions{e.ERC721Interactions.AtBlock(blockNumber), e.ierc721Enumerable, e.callError}
+}
+
+// AtBlockHash returns a read-only copy of the enumerable interactions whose calls are pinned to the given block hash.
+func (e *ERC721EnumerableInteractions) AtBlockHash(blockHash common.Hash) *ERC721EnumerableInteractions {
+	return &ERC721EnumerableInteractions{e.ERC721Interactions.AtBlockHash(blockHash), e.ierc721Enumerable, e.callError}
+}
+
+// Latest returns a copy of the enumerable interactions whose calls run against the latest block.
+func (e *ERC721EnumerableInteractions) Latest() *ERC721EnumerableInteractions {
+	return &ERC721EnumerableInteractions{e.ERC721Interactions.Latest(), e.ierc721Enumerable, e.callError}
+}
+
+// Pending returns a copy of the enumerable interactions whose calls run against the pending state.
+func (e *ERC721EnumerableInteractions) Pending() *ERC721EnumerableInteractions {
+	return &ERC721EnumerableInteractions{e.ERC721Interactions.Pending(), e.ierc721Enumerable, e.callError}
+}
+
 // GetAddressOwnedTokens returns a slice of token IDs owned by the specified address.
 func (e *ERC721EnumerableInteractions) GetAddressOwnedTokens(to common.Address) ([]*big.Int, error) {
-	balance, err := e.BalanceOf(to)
+	return e.GetAddressOwnedTokensCtx(e.Ctx, to)
+}
+
+// GetAddressOwnedTokensCtx returns a slice of token IDs owned by the specified address using ctx.
+func (e *ERC721EnumerableInteractions) GetAddressOwnedTokensCtx(
+	ctx context.Context,
+	to common.Address,
+) ([]*big.Int, error) {
+	balance, err := e.BalanceOfCtx(ctx, to)
 	if err != nil {
 		return nil, err
 	}
+	if multicall := e.NewMulticall(e.GetSession().CallOpts()); multicall != nil {
+		pack := func(index *big.Int) []byte {
+			return e.ierc721Enumerable.PackTokenOfOwnerByIndex(to, index)
+		}
+		return e.batchTokenIDs(ctx, multicall, balance.Int64(), "nft.TokenOfOwnerByIndex()",
+			pack, e.ierc721Enumerable.UnpackTokenOfOwnerByIndex)
+	}
+	if e.RPCBatching() {
+		read := func(index *big.Int) (*big.Int, error) {
+			return e.TokenOfOwnerByIndexCtx(ctx, to, index)
+		}
+		return e.concurrentTokenIDs(balance.Int64(), "nft.TokenOfOwnerByIndex()", read)
+	}
 	tokenIDs := []*big.Int{}
 	for i := range balance.Int64() {
-		tokenID, err := e.TokenOfOwnerByIndex(to, big.NewInt(i))
+		tokenID, err := e.TokenOfOwnerByIndexCtx(ctx, to, big.NewInt(i))
 		if err != nil {
 			return nil, e.callError("nft.TokenOfOwnerByIndex()", err)
 		}
@@ -61,13 +103,28 @@ func (e *ERC721EnumerableInteractions) GetAddressOwnedTokens(to common.Address)
 
 // GetAllTokenIDs returns all token IDs available in the contract.
 func (e *ERC721EnumerableInteractions) GetAllTokenIDs() ([]*big.Int, error) {
-	supply, err := e.TotalSupply()
+	return e.GetAllTokenIDsCtx(e.Ctx)
+}
+
+// GetAllTokenIDsCtx returns all token IDs available in the contract using ctx.
+func (e *ERC721EnumerableInteractions) GetAllTokenIDsCtx(ctx context.Context) ([]*big.Int, error) {
+	supply, err := e.TotalSupplyCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if multicall := e.NewMulticall(e.GetSession().CallOpts()); multicall != nil {
+		return e.batchTokenIDs(ctx, multicall, supply.Int64(), "nft.TokenByIndex()",
+			e.ierc721Enumerable.PackTokenByIndex, e.ierc721Enumerable.UnpackTokenByIndex)
+	}
+	if e.RPCBatching() {
+		read := func(index *big.Int) (*big.Int, error) {
+			return e.TokenByIndexCtx(ctx, index)
+		}
+		return e.concurrentTokenIDs(supply.Int64(), "nft.TokenByIndex()", read)
+	}
 	tokenIDs := []*big.Int{}
 	for i := range supply.Int64() {
-		tokenID, err := e.TokenByIndex(big.NewInt(i))
+		tokenID, err := e.TokenByIndexCtx(ctx, big.NewInt(i))
 		if err != nil {
 			return nil, e.callError("nft.TokenByIndex()", err)
 		}
@@ -76,9 +133,69 @@ func (e *ERC721EnumerableInteractions) GetAllTokenIDs() ([]*big.Int, error) {
 	return tokenIDs, nil
 }
 
+// batchTokenIDs retrieves the token IDs at indexes 0 to count-1 through multicall.
+func (e *ERC721EnumerableInteractions) batchTokenIDs(
+	ctx context.Context,
+	multicall *transaction.Multicall,
+	count int64,
+	method string,
+	pack func(index *big.Int) []byte,
+	unpack func([]byte) (*big.Int, error),
+) ([]*big.Int, error) {
+	results := make([]*transaction.Result[*big.Int], 0, count)
+	for i := range count {
+		results = append(results, transaction.AddCall(multicall, e.GetAddress(), pack(big.NewInt(i)), unpack, true))
+	}
+	if err := multicall.Execute(ctx); err != nil {
+		return nil, e.callError(method, err)
+	}
+
+	tokenIDs := make([]*big.Int, 0, count)
+	for _, result := range results {
+		tokenID, err := result.Get()
+		if err != nil {
+			return nil, e.callError(method, err)
+		}
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	return tokenIDs, nil
+}
+
+// concurrentTokenIDs reads the token IDs at indexes 0 to count-1 concurrently, so that the calls share
+// JSON-RPC batches.
+func (e *ERC721EnumerableInteractions) concurrentTokenIDs(
+	count int64,
+	method string,
+	read func(index *big.Int) (*big.Int, error),
+) ([]*big.Int, error) {
+	tokenIDs := make([]*big.Int, count)
+	err := transaction.Concurrently(int(count), func(idx int) error {
+		tokenID, err := read(big.NewInt(int64(idx)))
+		if err != nil {
+			return e.callError(method, err)
+		}
+		tokenIDs[idx] = tokenID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tokenIDs, nil
+}
+
 // TokenOfOwnerByIndex returns the token ID belonging to a specified address at a given index.
 func (e *ERC721EnumerableInteractions) TokenOfOwnerByIndex(to common.Address, index *big.Int) (*big.Int, error) {
-	tokenID, err := transaction.Call(
+	return e.TokenOfOwnerByIndexCtx(e.Ctx, to, index)
+}
+
+// TokenOfOwnerByIndexCtx returns the token ID belonging to a specified address at a given index using ctx.
+func (e *ERC721EnumerableInteractions) TokenOfOwnerByIndexCtx(
+	ctx context.Context,
+	to common.Address,
+	index *big.Int,
+) (*big.Int, error) {
+	tokenID, err := transaction.CallCtx(
+		ctx,
 		e.GetSession(),
 		e.ierc721Enumerable.PackTokenOfOwnerByIndex(to, index),
 		e.ierc721Enumerable.UnpackTokenOfOwnerByIndex,
@@ -92,7 +209,13 @@ func (e *ERC721EnumerableInteractions) TokenOfOwnerByIndex(to common.Address, in
 
 // TokenByIndex returns the token ID at a specific index in the contract.
 func (e *ERC721EnumerableInteractions) TokenByIndex(index *big.Int) (*big.Int, error) {
-	tokenID, err := transaction.Call(
+	return e.TokenByIndexCtx(e.Ctx, index)
+}
+
+// TokenByIndexCtx returns the token ID at a specific index in the contract using ctx.
+func (e *ERC721EnumerableInteractions) TokenByIndexCtx(ctx context.Context, index *big.Int) (*big.Int, error) {
+	tokenID, err := transaction.CallCtx(
+		ctx,
 		e.GetSession(),
 		e.ierc721Enumerable.PackTokenByIndex(index),
 		e.ierc721Enumerable.UnpackTokenByIndex,
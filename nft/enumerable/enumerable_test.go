@@ -3,6 +3,8 @@ package enumerable_test
 // Package enumerable_test contains tests for enumerable interactions.
 
 import (
+	"context"
+	"math/big"
 	"testing"
 
 	"github.com/Thektonic/eth-interfaces/base"
@@ -10,6 +12,7 @@ import (
 	"github.com/Thektonic/eth-interfaces/nft"
 	"github.com/Thektonic/eth-interfaces/nft/enumerable"
 	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -86,3 +89,173 @@ func Test_GetAllTokenIDs(t *testing.T) {
 
 	assert.Equal(t, supply.Int64(), int64(len(tokens)), "number of tokens should equal total supply")
 }
+
+// Test_AtBlock verifies that block-pinned enumerable views list the tokens owned at that block.
+func Test_AtBlock(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseinteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	nftA, err := nft.NewERC721Interactions(baseinteractions, *contractAddr, []nft.BaseNFTSignature{nft.BalanceOf})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	enum, err := enumerable.NewERC721EnumerableInteractions(nftA, []enumerable.IERC721EnumerableSignature{
+		enumerable.TokenOfOwnerByIndex,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	snapshot, err := backend.Client().BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	before, err := enum.GetAddressOwnedTokens(auth.From)
+	assert.Nil(t, err)
+
+	_, err = nftA.TransferTo(common.HexToAddress("0x1234"), before[0])
+	assert.Nil(t, err)
+	backend.Commit()
+
+	pinned, err := enum.AtBlock(new(big.Int).SetUint64(snapshot)).GetAddressOwnedTokens(auth.From)
+	assert.Nil(t, err)
+	assert.Equal(t, len(before), len(pinned))
+
+	latest, err := enum.Latest().GetAddressOwnedTokens(auth.From)
+	assert.Nil(t, err)
+	assert.Equal(t, len(before)-1, len(latest))
+}
+
+// Test_Multicall verifies that the token listings batched through Multicall3 match the per-call listings.
+func Test_Multicall(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	multicallAddr, err := testingtools.DeployMulticall3(auth, backend)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	baseinteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	nftA, err := nft.NewERC721Interactions(baseinteractions, *contractAddr, []nft.BaseNFTSignature{nft.BalanceOf})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	enum, err := enumerable.NewERC721EnumerableInteractions(nftA, []enumerable.IERC721EnumerableSignature{
+		enumerable.TokenByIndex,
+		enumerable.TokenOfOwnerByIndex,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	allTokens, err := enum.GetAllTokenIDs()
+	assert.Nil(t, err)
+	ownedTokens, err := enum.GetAddressOwnedTokens(auth.From)
+	assert.Nil(t, err)
+
+	baseinteractions.SetMulticall(*multicallAddr, 7)
+
+	batchedAllTokens, err := enum.GetAllTokenIDs()
+	assert.Nil(t, err)
+	assert.Equal(t, allTokens, batchedAllTokens)
+
+	batchedOwnedTokens, err := enum.GetAddressOwnedTokens(auth.From)
+	assert.Nil(t, err)
+	assert.Equal(t, ownedTokens, batchedOwnedTokens)
+}
+
+// Test_RPCBatching verifies that the token listings read through batched JSON-RPC calls match the per-call listings.
+func Test_RPCBatching(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseinteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	nftA, err := nft.NewERC721Interactions(baseinteractions, *contractAddr, []nft.BaseNFTSignature{nft.BalanceOf})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	enum, err := enumerable.NewERC721EnumerableInteractions(nftA, []enumerable.IERC721EnumerableSignature{
+		enumerable.TokenByIndex,
+		enumerable.TokenOfOwnerByIndex,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	allTokens, err := enum.GetAllTokenIDs()
+	assert.Nil(t, err)
+	ownedTokens, err := enum.GetAddressOwnedTokens(auth.From)
+	assert.Nil(t, err)
+
+	proxy, err := testingtools.NewCallProxy(backend)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer proxy.Close()
+
+	err = baseinteractions.EnableRPCBatching(proxy, 0, 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// The instances are bound to the batching backend when created.
+	nftB, err := nft.NewERC721Interactions(baseinteractions, *contractAddr, []nft.BaseNFTSignature{nft.BalanceOf})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	batched, err := enumerable.NewERC721EnumerableInteractions(nftB, []enumerable.IERC721EnumerableSignature{
+		enumerable.TokenByIndex,
+		enumerable.TokenOfOwnerByIndex,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	batchedAllTokens, err := batched.GetAllTokenIDs()
+	assert.Nil(t, err)
+	assert.Equal(t, allTokens, batchedAllTokens)
+
+	batchedOwnedTokens, err := batched.GetAddressOwnedTokens(auth.From)
+	assert.Nil(t, err)
+	assert.Equal(t, ownedTokens, batchedOwnedTokens)
+}
@@ -0,0 +1,156 @@
+package nft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MaxConsecutiveTransfer is the largest token range of a ConsecutiveTransfer event expanded into
+// transfers, the batch size limit of ERC-2309.
+const MaxConsecutiveTransfer = 5_000
+
+var (
+	// TransferEventID is the topic of the ERC721 Transfer event.
+	TransferEventID = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// ApprovalEventID is the topic of the ERC721 Approval event.
+	ApprovalEventID = crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+	// ApprovalForAllEventID is the topic of the ERC721 ApprovalForAll event.
+	ApprovalForAllEventID = crypto.Keccak256Hash([]byte("ApprovalForAll(address,address,bool)"))
+	// ConsecutiveTransferEventID is the topic of the ERC-2309 ConsecutiveTransfer event.
+	ConsecutiveTransferEventID = crypto.Keccak256Hash([]byte("ConsecutiveTransfer(uint256,uint256,address,address)"))
+)
+
+// WatchFilter restricts the watched events to the given addresses, empty lists matching any address.
+type WatchFilter struct {
+	// From matches the sender of transfers and the owner of approvals.
+	From []common.Address
+	// To matches the recipient of transfers, the approved address of approvals and the operator
+	// of approvals for all.
+	To []common.Address
+	// PollInterval is the interval logs are polled at when the client does not support subscriptions,
+	// transaction.DefaultWatchPollInterval when 0.
+	PollInterval time.Duration
+}
+
+// WatchTransfers delivers the transfers of the collection emitted from now on. ConsecutiveTransfer events
+// are expanded into one transfer per token, all holding the ConsecutiveTransfer log as Raw log.
+func (d *ERC721Interactions) WatchTransfers(
+	ctx context.Context,
+	filter WatchFilter,
+) (*transaction.Subscription[*inferences.Ierc721Transfer], error) {
+	// Transfer and ConsecutiveTransfer index their addresses at different positions, the addresses
+	// are hence matched once the logs are decoded.
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{d.nftAddress},
+		Topics:    [][]common.Hash{{TransferEventID, ConsecutiveTransferEventID}},
+	}
+	decode := func(log types.Log) ([]*inferences.Ierc721Transfer, error) {
+		transfers, err := d.decodeTransfers(&log)
+		if err != nil {
+			return nil, err
+		}
+		return slices.DeleteFunc(transfers, func(transfer *inferences.Ierc721Transfer) bool {
+			return !matches(filter.From, transfer.From) || !matches(filter.To, transfer.To)
+		}), nil
+	}
+	sub, err := transaction.WatchLogs(ctx, d.Client, query, filter.PollInterval, decode)
+	if err != nil {
+		return nil, d.callError("WatchTransfers()", err)
+	}
+	return sub, nil
+}
+
+// WatchApprovals delivers the approvals of the collection emitted from now on.
+func (d *ERC721Interactions) WatchApprovals(
+	ctx context.Context,
+	filter WatchFilter,
+) (*transaction.Subscription[*inferences.Ierc721Approval], error) {
+	query := d.eventQuery(ApprovalEventID, filter)
+	decode := func(log types.Log) ([]*inferences.Ierc721Approval, error) {
+		approval, err := d.erc721.UnpackApprovalEvent(&log)
+		if err != nil {
+			return nil, err
+		}
+		return []*inferences.Ierc721Approval{approval}, nil
+	}
+	sub, err := transaction.WatchLogs(ctx, d.Client, query, filter.PollInterval, decode)
+	if err != nil {
+		return nil, d.callError("WatchApprovals()", err)
+	}
+	return sub, nil
+}
+
+// WatchApprovalsForAll delivers the operator approvals of the collection emitted from now on.
+func (d *ERC721Interactions) WatchApprovalsForAll(
+	ctx context.Context,
+	filter WatchFilter,
+) (*transaction.Subscription[*inferences.Ierc721ApprovalForAll], error) {
+	query := d.eventQuery(ApprovalForAllEventID, filter)
+	decode := func(log types.Log) ([]*inferences.Ierc721ApprovalForAll, error) {
+		approval, err := d.erc721.UnpackApprovalForAllEvent(&log)
+		if err != nil {
+			return nil, err
+		}
+		return []*inferences.Ierc721ApprovalForAll{approval}, nil
+	}
+	sub, err := transaction.WatchLogs(ctx, d.Client, query, filter.PollInterval, decode)
+	if err != nil {
+		return nil, d.callError("WatchApprovalsForAll()", err)
+	}
+	return sub, nil
+}
+
+// decodeTransfers decodes a Transfer log, or expands a ConsecutiveTransfer log into one transfer per token.
+func (d *ERC721Interactions) decodeTransfers(log *types.Log) ([]*inferences.Ierc721Transfer, error) {
+	if len(log.Topics) == 0 || log.Topics[0] != ConsecutiveTransferEventID {
+		transfer, err := d.erc721.UnpackTransferEvent(log)
+		if err != nil {
+			return nil, err
+		}
+		return []*inferences.Ierc721Transfer{transfer}, nil
+	}
+
+	consecutive, err := d.erc721.UnpackConsecutiveTransferEvent(log)
+	if err != nil {
+		return nil, err
+	}
+	count := new(big.Int).Sub(consecutive.ToTokenId, consecutive.FromTokenId)
+	if count.Sign() < 0 || count.Cmp(big.NewInt(MaxConsecutiveTransfer)) >= 0 {
+		return nil, fmt.Errorf("invalid ConsecutiveTransfer range %s to %s",
+			consecutive.FromTokenId.String(), consecutive.ToTokenId.String())
+	}
+	transfers := make([]*inferences.Ierc721Transfer, 0, count.Int64()+1)
+	for tokenID := new(big.Int).Set(consecutive.FromTokenId); tokenID.Cmp(consecutive.ToTokenId) <= 0; {
+		transfers = append(transfers, &inferences.Ierc721Transfer{
+			From:    consecutive.From,
+			To:      consecutive.To,
+			TokenId: new(big.Int).Set(tokenID),
+			Raw:     log,
+		})
+		tokenID.Add(tokenID, common.Big1)
+	}
+	return transfers, nil
+}
+
+// eventQuery builds the log query of an event of the collection indexing its two addresses first.
+func (d *ERC721Interactions) eventQuery(eventID common.Hash, filter WatchFilter) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{d.nftAddress},
+		Topics:    [][]common.Hash{{eventID}, transaction.AddressTopics(filter.From), transaction.AddressTopics(filter.To)},
+	}
+}
+
+// matches reports whether address is in addresses, an empty list matching any address.
+func matches(addresses []common.Address, address common.Address) bool {
+	return len(addresses) == 0 || slices.Contains(addresses, address)
+}
@@ -0,0 +1,226 @@
+package nft_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/nft"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum"
+	bind2 "github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// pollingClient is a client without log subscriptions, like plain HTTP endpoints. The extra logs are
+// returned along with the first query results.
+type pollingClient struct {
+	simulated.Client
+	mu    sync.Mutex
+	extra []types.Log
+}
+
+func (c *pollingClient) SubscribeFilterLogs(
+	context.Context,
+	ethereum.FilterQuery,
+	chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return nil, rpc.ErrNotificationsUnsupported
+}
+
+func (c *pollingClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := c.Client.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	logs = append(c.extra, logs...)
+	c.extra = nil
+	return logs, nil
+}
+
+// receive returns the next event of the subscription, failing the test after a timeout.
+func receive[T any](t *testing.T, sub *transaction.Subscription[T]) (T, bool) {
+	t.Helper()
+	var zero T
+	select {
+	case event, ok := <-sub.Events():
+		if !ok {
+			t.Errorf("subscription ended: %v", <-sub.Err())
+		}
+		return event, ok
+	case <-time.After(5 * time.Second):
+		t.Error("no event received")
+		return zero, false
+	}
+}
+
+// Test_WatchEvents verifies that transfers and approvals are delivered through log subscriptions.
+func Test_WatchEvents(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recipient := common.HexToAddress("0x1001")
+	operator := common.HexToAddress("0x1002")
+	ctx := context.Background()
+
+	transfers, err := nftA.WatchTransfers(ctx, nft.WatchFilter{To: []common.Address{recipient}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transfers.Unsubscribe()
+	approvals, err := nftA.WatchApprovals(ctx, nft.WatchFilter{From: []common.Address{auth.From}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer approvals.Unsubscribe()
+	approvalsForAll, err := nftA.WatchApprovalsForAll(ctx, nft.WatchFilter{To: []common.Address{operator}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer approvalsForAll.Unsubscribe()
+
+	if _, err := nftA.Approve(operator, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	approval, ok := receive(t, approvals)
+	if ok {
+		assert.Equal(t, auth.From, approval.Owner)
+		assert.Equal(t, operator, approval.Approved)
+		assert.Equal(t, int64(1), approval.TokenId.Int64())
+	}
+
+	// The transfer to another address is filtered out.
+	if _, err := nftA.TransferFirstOwnedTo(common.HexToAddress("0x1003")); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if _, err := nftA.TransferFirstOwnedTo(recipient); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	transfer, ok := receive(t, transfers)
+	if ok {
+		assert.Equal(t, auth.From, transfer.From)
+		assert.Equal(t, recipient, transfer.To)
+		owner, err := nftA.OwnerOf(transfer.TokenId)
+		assert.Nil(t, err)
+		assert.Equal(t, recipient, owner)
+	}
+
+	erc721 := inferences.NewIerc721()
+	instance := erc721.Instance(backend.Client(), *contractAddr)
+	if _, err := bind2.Transact(instance, auth, erc721.PackSetApprovalForAll(operator, true)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	approvalForAll, ok := receive(t, approvalsForAll)
+	if ok {
+		assert.Equal(t, auth.From, approvalForAll.Owner)
+		assert.Equal(t, operator, approvalForAll.Operator)
+		assert.True(t, approvalForAll.Approved)
+	}
+
+	transfers.Unsubscribe()
+	_, ok = <-transfers.Events()
+	assert.False(t, ok)
+}
+
+// Test_WatchPolling verifies the polling fallback and the expansion of ConsecutiveTransfer events.
+func Test_WatchPolling(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	recipient := common.HexToAddress("0x1001")
+	client := &pollingClient{Client: backend.Client()}
+	client.extra = []types.Log{{
+		Address: *contractAddr,
+		Topics: []common.Hash{
+			nft.ConsecutiveTransferEventID,
+			common.BigToHash(big.NewInt(100)),
+			{},
+			common.BytesToHash(recipient.Bytes()),
+		},
+		Data: common.BigToHash(big.NewInt(102)).Bytes(),
+	}}
+
+	baseInteractions := base.NewBaseInteractions(client, privKey, nil, false)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transfers, err := nftA.WatchTransfers(context.Background(), nft.WatchFilter{
+		To:           []common.Address{recipient},
+		PollInterval: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transfers.Unsubscribe()
+
+	if _, err := nftA.TransferFirstOwnedTo(recipient); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	for _, tokenID := range []int64{100, 101, 102} {
+		transfer, ok := receive(t, transfers)
+		if !ok {
+			return
+		}
+		assert.Equal(t, common.Address{}, transfer.From)
+		assert.Equal(t, recipient, transfer.To)
+		assert.Equal(t, tokenID, transfer.TokenId.Int64())
+	}
+
+	transfer, ok := receive(t, transfers)
+	if ok {
+		assert.Equal(t, auth.From, transfer.From)
+		assert.Equal(t, recipient, transfer.To)
+	}
+}
@@ -0,0 +1,238 @@
+package nft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultInventorySample is the number of tokens whose owner is checked with OwnerOf when an
+// inventory is built by OwnedTokens.
+const DefaultInventorySample = 8
+
+// InventoryMismatchError is returned when the owner of a token rebuilt from the transfer logs differs
+// from the one returned by OwnerOf, e.g. for collections minting or moving tokens without events.
+type InventoryMismatchError struct {
+	TokenID  *big.Int
+	Expected common.Address
+	Actual   common.Address
+}
+
+func (e *InventoryMismatchError) Error() string {
+	return fmt.Sprintf("inventory mismatch for token %s: logs give owner %s, ownerOf returns %s",
+		e.TokenID.String(), e.Expected.Hex(), e.Actual.Hex())
+}
+
+// inventoryEntry is a token and its current owner.
+type inventoryEntry struct {
+	tokenID *big.Int
+	owner   common.Address
+}
+
+// Inventory is the ownership of the tokens of a collection at a block, rebuilt from its Transfer and
+// ConsecutiveTransfer logs. Burnt tokens are not part of the inventory.
+type Inventory struct {
+	// Block is the last block whose transfers are applied.
+	Block uint64
+
+	tokens map[string]*inventoryEntry
+}
+
+// OwnerOf returns the owner of the token and whether the token is part of the inventory.
+func (inv *Inventory) OwnerOf(tokenID *big.Int) (common.Address, bool) {
+	entry, ok := inv.tokens[tokenID.String()]
+	if !ok {
+		return common.Address{}, false
+	}
+	return entry.owner, true
+}
+
+// OwnedTokens returns the tokens owned by owner in ascending order.
+func (inv *Inventory) OwnedTokens(owner common.Address) []*big.Int {
+	var tokenIDs []*big.Int
+	for _, entry := range inv.tokens {
+		if entry.owner == owner {
+			tokenIDs = append(tokenIDs, new(big.Int).Set(entry.tokenID))
+		}
+	}
+	slices.SortFunc(tokenIDs, (*big.Int).Cmp)
+	return tokenIDs
+}
+
+// Len returns the number of tokens in the inventory.
+func (inv *Inventory) Len() int {
+	return len(inv.tokens)
+}
+
+// apply records the transfer of a token, removing the tokens sent to the zero address.
+func (inv *Inventory) apply(tokenID *big.Int, to common.Address) {
+	if to == (common.Address{}) {
+		delete(inv.tokens, tokenID.String())
+		return
+	}
+	inv.tokens[tokenID.String()] = &inventoryEntry{tokenID: new(big.Int).Set(tokenID), owner: to}
+}
+
+// BuildInventory rebuilds the ownership of the collection's tokens from the transfers emitted since
+// fromBlock, the collection's deployment block or earlier, and checks the owner of sample random
+// tokens with OwnerOf.
+func (d *ERC721Interactions) BuildInventory(fromBlock uint64, sample int) (*Inventory, error) {
+	return d.BuildInventoryCtx(d.Ctx, fromBlock, sample)
+}
+
+// BuildInventoryCtx rebuilds the ownership of the collection's tokens using ctx.
+func (d *ERC721Interactions) BuildInventoryCtx(ctx context.Context, fromBlock uint64, sample int) (*Inventory, error) {
+	inv := &Inventory{tokens: make(map[string]*inventoryEntry)}
+	if fromBlock > 0 {
+		inv.Block = fromBlock - 1
+	}
+	if err := d.updateInventory(ctx, inv, fromBlock); err != nil {
+		return nil, d.callError("BuildInventory()", err)
+	}
+	if err := d.VerifyInventoryCtx(ctx, inv, sample); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// UpdateInventory applies the transfers emitted after the inventory's block. Reorganisations are
+// not tracked: the blocks already applied are expected to be final.
+func (d *ERC721Interactions) UpdateInventory(inv *Inventory) error {
+	return d.UpdateInventoryCtx(d.Ctx, inv)
+}
+
+// UpdateInventoryCtx applies the transfers emitted after the inventory's block using ctx.
+func (d *ERC721Interactions) UpdateInventoryCtx(ctx context.Context, inv *Inventory) error {
+	if err := d.updateInventory(ctx, inv, inv.Block+1); err != nil {
+		return d.callError("UpdateInventory()", err)
+	}
+	return nil
+}
+
+// VerifyInventory checks the owner of sample random tokens of the inventory with OwnerOf at the
+// inventory's block, all of them when sample exceeds the inventory size.
+func (d *ERC721Interactions) VerifyInventory(inv *Inventory, sample int) error {
+	return d.VerifyInventoryCtx(d.Ctx, inv, sample)
+}
+
+// VerifyInventoryCtx checks the owner of sample random tokens of the inventory using ctx.
+func (d *ERC721Interactions) VerifyInventoryCtx(ctx context.Context, inv *Inventory, sample int) error {
+	entries := make([]*inventoryEntry, 0, len(inv.tokens))
+	for _, entry := range inv.tokens {
+		entries = append(entries, entry)
+	}
+	rand.Shuffle(len(entries), func(i, j int) { entries[i], entries[j] = entries[j], entries[i] })
+	entries = entries[:min(max(sample, 0), len(entries))]
+
+	view := d.AtBlock(new(big.Int).SetUint64(inv.Block))
+	owners := make([]common.Address, len(entries))
+	errs := make([]error, len(entries))
+	reads := make([]func(), len(entries))
+	for idx, entry := range entries {
+		reads[idx] = func() { owners[idx], errs[idx] = view.OwnerOfCtx(ctx, entry.tokenID) }
+	}
+	d.RunReads(reads...)
+
+	for idx, entry := range entries {
+		if errs[idx] != nil {
+			return errs[idx]
+		}
+		if owners[idx] != entry.owner {
+			return &InventoryMismatchError{TokenID: entry.tokenID, Expected: entry.owner, Actual: owners[idx]}
+		}
+	}
+	return nil
+}
+
+// OwnedTokens returns the tokens owned by owner in ascending order, rebuilt from the collection's
+// transfer logs. Unlike the enumerable extension, it works for any ERC721 collection but scans the
+// logs from the genesis block on every call; use BuildInventory and UpdateInventory for repeated lookups.
+func (d *ERC721Interactions) OwnedTokens(owner common.Address) ([]*big.Int, error) {
+	return d.OwnedTokensCtx(d.Ctx, owner)
+}
+
+// OwnedTokensCtx returns the tokens owned by owner in ascending order using ctx.
+func (d *ERC721Interactions) OwnedTokensCtx(ctx context.Context, owner common.Address) ([]*big.Int, error) {
+	inv, err := d.BuildInventoryCtx(ctx, 0, DefaultInventorySample)
+	if err != nil {
+		return nil, err
+	}
+	return inv.OwnedTokens(owner), nil
+}
+
+// TransferFirstOwnedFromInventory transfers the lowest token owned by the signer in the inventory to
+// the specified address, after applying the transfers emitted since the inventory's block. Unlike
+// TransferFirstOwnedTo, it does not try the token IDs one by one.
+func (d *ERC721Interactions) TransferFirstOwnedFromInventory(
+	inv *Inventory,
+	to common.Address,
+) (*types.Transaction, error) {
+	return d.TransferFirstOwnedFromInventoryCtx(d.Ctx, inv, to)
+}
+
+// TransferFirstOwnedFromInventoryCtx transfers the lowest token owned by the signer in the inventory
+// using ctx.
+func (d *ERC721Interactions) TransferFirstOwnedFromInventoryCtx(
+	ctx context.Context,
+	inv *Inventory,
+	to common.Address,
+) (*types.Transaction, error) {
+	if err := d.UpdateInventoryCtx(ctx, inv); err != nil {
+		return nil, err
+	}
+	owned := inv.OwnedTokens(d.Address)
+	if len(owned) == 0 {
+		return nil, errors.New("no nft found from signer")
+	}
+	return d.TransferToCtx(ctx, to, owned[0])
+}
+
+// updateInventory applies the transfers emitted from block from to the latest block.
+func (d *ERC721Interactions) updateInventory(ctx context.Context, inv *Inventory, from uint64) error {
+	head, err := d.Client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if from > head {
+		return nil
+	}
+	logs, err := transaction.FilterLogs(ctx, d.Client, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(head),
+		Addresses: []common.Address{d.nftAddress},
+		Topics:    [][]common.Hash{{TransferEventID, ConsecutiveTransferEventID}},
+	})
+	if err != nil {
+		return err
+	}
+	for idx := range logs {
+		if err := d.applyLog(inv, &logs[idx]); err != nil {
+			return err
+		}
+	}
+	inv.Block = head
+	return nil
+}
+
+// applyLog records the transfers of a log in the inventory.
+func (d *ERC721Interactions) applyLog(inv *Inventory, log *types.Log) error {
+	if log.Removed {
+		return nil
+	}
+	transfers, err := d.decodeTransfers(log)
+	if err != nil {
+		return fmt.Errorf("failed to decode log %d of transaction %s: %w", log.Index, log.TxHash.Hex(), err)
+	}
+	for _, transfer := range transfers {
+		inv.apply(transfer.TokenId, transfer.To)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package nft_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/nft"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+// forgedLogsClient appends forged logs to the results of the log queries.
+type forgedLogsClient struct {
+	simulated.Client
+	forged []types.Log
+}
+
+func (c *forgedLogsClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := c.Client.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return append(logs, c.forged...), nil
+}
+
+// Test_Inventory verifies that the ownership rebuilt from the transfer logs follows the transfers.
+func Test_Inventory(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	supply, err := nftA.TotalSupply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inv, err := nftA.BuildInventory(0, int(supply.Int64()))
+	assert.Nil(t, err)
+	assert.Equal(t, int(supply.Int64()), inv.Len())
+	owned := inv.OwnedTokens(auth.From)
+	assert.Equal(t, int(supply.Int64()), len(owned))
+	assert.Equal(t, int64(0), owned[0].Int64())
+
+	recipient := common.HexToAddress("0x2001")
+	if _, err := nftA.TransferTo(recipient, big.NewInt(2)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if _, err := nftA.TransferFirstOwnedTo(recipient); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	assert.Nil(t, nftA.UpdateInventory(inv))
+	assert.Nil(t, nftA.VerifyInventory(inv, inv.Len()))
+	owner, ok := inv.OwnerOf(big.NewInt(2))
+	assert.True(t, ok)
+	assert.Equal(t, recipient, owner)
+	assert.Equal(t, []*big.Int{big.NewInt(0), big.NewInt(2)}, inv.OwnedTokens(recipient))
+	assert.Equal(t, int(supply.Int64())-2, len(inv.OwnedTokens(auth.From)))
+
+	tokens, err := nftA.OwnedTokens(recipient)
+	assert.Nil(t, err)
+	assert.Equal(t, inv.OwnedTokens(recipient), tokens)
+	tokens, err = nftA.OwnedTokens(common.HexToAddress("0x2002"))
+	assert.Nil(t, err)
+	assert.Empty(t, tokens)
+
+	if _, err := nftA.TransferFirstOwnedFromInventory(inv, recipient); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	owner, err = nftA.OwnerOf(big.NewInt(1))
+	assert.Nil(t, err)
+	assert.Equal(t, recipient, owner)
+}
+
+// Test_InventoryMismatch verifies that transfer logs disagreeing with OwnerOf are reported,
+// and that the tokens sent to the zero address leave the inventory.
+func Test_InventoryMismatch(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc721MetaData.ABI,
+		inferences.Ierc721MetaData.Bin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	transferLog := func(tokenID int64, to common.Address) types.Log {
+		return types.Log{
+			Address: *contractAddr,
+			Topics: []common.Hash{
+				nft.TransferEventID,
+				common.BytesToHash(auth.From.Bytes()),
+				common.BytesToHash(to.Bytes()),
+				common.BigToHash(big.NewInt(tokenID)),
+			},
+		}
+	}
+
+	tests := []struct {
+		Name          string
+		Extra         types.Log
+		ExpectError   bool
+		ExpectedError *nft.InventoryMismatchError
+	}{
+		{
+			Name:  "OK - Token sent to the zero address",
+			Extra: transferLog(3, common.Address{}),
+		},
+		{
+			Name:        "NOK - Owner differs from ownerOf",
+			Extra:       transferLog(3, common.HexToAddress("0x2003")),
+			ExpectError: true,
+			ExpectedError: &nft.InventoryMismatchError{
+				TokenID:  big.NewInt(3),
+				Expected: common.HexToAddress("0x2003"),
+				Actual:   auth.From,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			client := &forgedLogsClient{Client: backend.Client(), forged: []types.Log{tt.Extra}}
+			baseInteractions := base.NewBaseInteractions(client, privKey, nil, false)
+			nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			inv, err := nftA.BuildInventory(0, 100)
+			if tt.ExpectError {
+				var mismatch *nft.InventoryMismatchError
+				assert.True(t, errors.As(err, &mismatch))
+				assert.Equal(t, tt.ExpectedError, mismatch)
+				return
+			}
+			assert.Nil(t, err)
+			_, ok := inv.OwnerOf(big.NewInt(3))
+			assert.False(t, ok)
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package royalties
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/Thektonic/eth-interfaces/base"
@@ -10,6 +11,7 @@ import (
 	"github.com/Thektonic/eth-interfaces/inferences"
 	"github.com/Thektonic/eth-interfaces/nft"
 	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // IERC721RoyaltiesInteractions wraps interactions with the IERC721Royalties contract.
@@ -41,12 +43,42 @@ func NewERC721RoyaltiesInteractions(
 	return &IERC721RoyaltiesInteractions{baseIERC721, ierc721Royalties, callError}, nil
 }
 
+// AtBlock returns a read-only copy of the royalties interactions whose calls are pinned to the given block.
+func (e *IERC721RoyaltiesInteractions) AtBlock(blockNumber *big.Int) *IERC721RoyaltiesInteractions {
+	return &IERC721RoyaltiesInteractions{e.ERC721Interactions.AtBlock(blockNumber), e.ierc721Royalties, e.callError}
+}
+
+// AtBlockHash returns a read-only copy of the royalties interactions whose calls are pinned to the given block hash.
+func (e *IERC721RoyaltiesInteractions) AtBlockHash(blockHash common.Hash) *IERC721RoyaltiesInteractions {
+	return &IERC721RoyaltiesInteractions{e.ERC721Interactions.AtBlockHash(blockHash), e.ierc721Royalties, e.callError}
+}
+
+// Latest returns a copy of the royalties interactions whose calls run against the latest block.
+func (e *IERC721RoyaltiesInteractions) Latest() *IERC721RoyaltiesInteractions {
+	return &IERC721RoyaltiesInteractions{e.ERC721Interactions.Latest(), e.ierc721Royalties, e.callError}
+}
+
+// Pending returns a copy of the royalties interactions whose calls run against the pending state.
+func (e *IERC721RoyaltiesInteractions) Pending() *IERC721RoyaltiesInteractions {
+	return &IERC721RoyaltiesInteractions{e.ERC721Interactions.Pending(), e.ierc721Royalties, e.callError}
+}
+
 // RoyaltiesInfos retrieves the royalty information for a given token and sale price.
 func (e *IERC721RoyaltiesInteractions) RoyaltiesInfos(
 	tokenID *big.Int,
 	salePrice *big.Int,
 ) (inferences.RoyaltyInfoOutput, error) {
-	rInfos, err := transaction.Call(
+	return e.RoyaltiesInfosCtx(e.Ctx, tokenID, salePrice)
+}
+
+// RoyaltiesInfosCtx retrieves the royalty information for a given token and sale price using ctx.
+func (e *IERC721RoyaltiesInteractions) RoyaltiesInfosCtx(
+	ctx context.Context,
+	tokenID *big.Int,
+	salePrice *big.Int,
+) (inferences.RoyaltyInfoOutput, error) {
+	rInfos, err := transaction.CallCtx(
+		ctx,
 		e.GetSession(),
 		e.ierc721Royalties.PackRoyaltyInfo(tokenID, salePrice),
 		e.ierc721Royalties.UnpackRoyaltyInfo,
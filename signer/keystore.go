@@ -0,0 +1,26 @@
+package signer
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// NewKeystoreSigner creates a signer from an encrypted go-ethereum keystore file.
+func NewKeystoreSigner(path, passphrase string) (*KeySigner, error) {
+	keyJSON, err := os.ReadFile(path) // #nosec G304 -- the keystore path is provided by the caller
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore file: %w", err)
+	}
+	return NewKeystoreJSONSigner(keyJSON, passphrase)
+}
+
+// NewKeystoreJSONSigner creates a signer from the content of an encrypted go-ethereum keystore file.
+func NewKeystoreJSONSigner(keyJSON []byte, passphrase string) (*KeySigner, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	return NewKeySigner(key.PrivateKey)
+}
@@ -0,0 +1,191 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// ClefNamespace is the JSON-RPC namespace exposed by Clef (account_signTransaction, ...).
+	ClefNamespace = "account"
+	// EthNamespace is the JSON-RPC namespace of nodes exposing eth_signTransaction.
+	EthNamespace = "eth"
+)
+
+// signTransactionResult is the response of the signTransaction JSON-RPC methods.
+type signTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+// RemoteSigner delegates signing to a remote signer speaking the Clef or eth_signTransaction JSON-RPC protocol.
+type RemoteSigner struct {
+	client    *rpc.Client
+	address   common.Address
+	namespace string
+}
+
+// NewRemoteSigner creates a signer for address on top of an RPC client, using the given
+// namespace (ClefNamespace or EthNamespace).
+func NewRemoteSigner(client *rpc.Client, address common.Address, namespace string) (*RemoteSigner, error) {
+	if client == nil {
+		return nil, fmt.Errorf("rpc client cannot be nil")
+	}
+	if namespace != ClefNamespace && namespace != EthNamespace {
+		return nil, fmt.Errorf("unsupported signer namespace: %s", namespace)
+	}
+	return &RemoteSigner{client: client, address: address, namespace: namespace}, nil
+}
+
+// DialRemoteSigner connects to the remote signer at endpoint and creates a signer for address.
+func DialRemoteSigner(
+	ctx context.Context,
+	endpoint string,
+	address common.Address,
+	namespace string,
+) (*RemoteSigner, error) {
+	client, err := rpc.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+	return NewRemoteSigner(client, address, namespace)
+}
+
+// Address returns the account the remote signer signs for.
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx asks the remote signer to sign the transaction and checks the returned signature.
+func (s *RemoteSigner) SignTx(
+	ctx context.Context,
+	tx *types.Transaction,
+	chainID *big.Int,
+) (*types.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(s.address),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if to := tx.To(); to != nil {
+		mixed := common.NewMixedcaseAddress(*to)
+		args.To = &mixed
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	default:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		if accessList := tx.AccessList(); len(accessList) > 0 {
+			args.AccessList = &accessList
+		}
+	}
+
+	var result signTransactionResult
+	if err := s.client.CallContext(ctx, &result, s.namespace+"_signTransaction", args); err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign the tx: %w", err)
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(result.Raw); err != nil {
+		return nil, fmt.Errorf("failed to decode signed tx: %w", err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover signed tx sender: %w", err)
+	}
+	if sender != s.address {
+		return nil, fmt.Errorf("remote signer signed for %s instead of %s", sender.Hex(), s.address.Hex())
+	}
+	if err := compareSigned(tx, signed, chainID); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// compareSigned checks that the remote signer signed the requested transaction, as signers may fill in
+// or rewrite fields such as the nonce, which would then be broadcast unnoticed.
+func compareSigned(requested, signed *types.Transaction, chainID *big.Int) error {
+	mismatch := func(field string, want, got any) error {
+		return fmt.Errorf("%w: %s %v instead of %v", ErrTxMismatch, field, got, want)
+	}
+	switch {
+	case signed.ChainId().Cmp(chainID) != 0:
+		return mismatch("chain ID", chainID, signed.ChainId())
+	case signed.Nonce() != requested.Nonce():
+		return mismatch("nonce", requested.Nonce(), signed.Nonce())
+	case !sameRecipient(signed.To(), requested.To()):
+		return mismatch("recipient", requested.To(), signed.To())
+	case signed.Value().Cmp(requested.Value()) != 0:
+		return mismatch("value", requested.Value(), signed.Value())
+	case !bytes.Equal(signed.Data(), requested.Data()):
+		return mismatch("data", hexutil.Bytes(requested.Data()), hexutil.Bytes(signed.Data()))
+	case signed.Gas() != requested.Gas():
+		return mismatch("gas", requested.Gas(), signed.Gas())
+	case signed.GasFeeCap().Cmp(requested.GasFeeCap()) != 0:
+		return mismatch("max fee", requested.GasFeeCap(), signed.GasFeeCap())
+	case signed.GasTipCap().Cmp(requested.GasTipCap()) != 0:
+		return mismatch("max priority fee", requested.GasTipCap(), signed.GasTipCap())
+	}
+	return nil
+}
+
+// sameRecipient reports whether both recipients are the same address, or both contract creations.
+func sameRecipient(a, b *common.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// SignHash is not supported: the signing protocols only sign prefixed or structured data.
+func (s *RemoteSigner) SignHash(context.Context, common.Hash) ([]byte, error) {
+	return nil, ErrUnsupported
+}
+
+// SignTypedData asks the remote signer to sign EIP-712 typed data.
+func (s *RemoteSigner) SignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error) {
+	method := ClefNamespace + "_signTypedData"
+	if s.namespace == EthNamespace {
+		method = EthNamespace + "_signTypedData_v4"
+	}
+
+	var sig hexutil.Bytes
+	if err := s.client.CallContext(ctx, &sig, method, common.NewMixedcaseAddress(s.address), typedData); err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign typed data: %w", err)
+	}
+	return sig, nil
+}
+
+// SignMessage asks the remote signer to sign an EIP-191 personal message, through account_signData for
+// Clef and eth_sign otherwise.
+func (s *RemoteSigner) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
+	var sig hexutil.Bytes
+	var err error
+	if s.namespace == EthNamespace {
+		err = s.client.CallContext(ctx, &sig, EthNamespace+"_sign", s.address, hexutil.Bytes(message))
+	} else {
+		err = s.client.CallContext(
+			ctx, &sig, ClefNamespace+"_signData", accounts.MimetypeTextPlain,
+			common.NewMixedcaseAddress(s.address), hexutil.Bytes(message),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign message: %w", err)
+	}
+	return sig, nil
+}
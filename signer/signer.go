@@ -0,0 +1,95 @@
+// Package signer provides the signing abstraction used by interactions to authorize transactions
+// and messages without requiring an in-memory private key.
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// recoveryIDOffset is added to the recovery ID of typed data and message signatures, following wallet
+// conventions.
+const recoveryIDOffset = 27
+
+var (
+	// ErrUnsupported is returned by signers that cannot perform a given kind of signature.
+	ErrUnsupported = errors.New("operation not supported by signer")
+	// ErrTxMismatch is returned when a remote signer returns a transaction differing from the one requested.
+	ErrTxMismatch = errors.New("signed tx differs from the requested one")
+)
+
+// Signer defines the operations required to authorize transactions and messages for an account.
+type Signer interface {
+	// Address returns the account the signer signs for.
+	Address() common.Address
+	// SignTx signs a transaction for the given chain ID.
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignHash signs a 32 bytes digest, returning a [R || S || V] signature with V being 0 or 1.
+	SignHash(ctx context.Context, hash common.Hash) ([]byte, error)
+	// SignTypedData signs EIP-712 typed data, returning a [R || S || V] signature with V being 27 or 28.
+	SignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error)
+	// SignMessage signs an EIP-191 personal message, returning a [R || S || V] signature with V being 27 or 28.
+	SignMessage(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// KeySigner signs with a private key held in memory.
+type KeySigner struct {
+	pk      *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKeySigner creates a signer from a raw private key.
+func NewKeySigner(pk *ecdsa.PrivateKey) (*KeySigner, error) {
+	if pk == nil {
+		return nil, fmt.Errorf("private key cannot be nil")
+	}
+	return &KeySigner{pk: pk, address: crypto.PubkeyToAddress(pk.PublicKey)}, nil
+}
+
+// Address returns the address derived from the private key.
+func (s *KeySigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx signs the transaction with the latest signer for the chain ID.
+func (s *KeySigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.pk)
+}
+
+// SignHash signs the digest with the private key.
+func (s *KeySigner) SignHash(_ context.Context, hash common.Hash) ([]byte, error) {
+	return crypto.Sign(hash.Bytes(), s.pk)
+}
+
+// SignTypedData hashes and signs EIP-712 typed data.
+func (s *KeySigner) SignTypedData(ctx context.Context, typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+	sig, err := s.SignHash(ctx, common.BytesToHash(hash))
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += recoveryIDOffset
+	return sig, nil
+}
+
+// SignMessage hashes the message with the EIP-191 personal message prefix and signs it.
+func (s *KeySigner) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
+	sig, err := s.SignHash(ctx, common.BytesToHash(accounts.TextHash(message)))
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += recoveryIDOffset
+	return sig, nil
+}
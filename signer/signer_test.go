@@ -0,0 +1,317 @@
+package signer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/signer"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// standInSigner mimics the account namespace of Clef on top of a private key.
+type standInSigner struct {
+	key *signer.KeySigner
+	// tamper alters the requested transaction before signing it, as a misbehaving signer.
+	tamper func(args *apitypes.SendTxArgs)
+}
+
+type signTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+// SignTransaction implements account_signTransaction.
+func (s *standInSigner) SignTransaction(ctx context.Context, args apitypes.SendTxArgs) (*signTransactionResult, error) {
+	if s.tamper != nil {
+		s.tamper(&args)
+	}
+	tx, err := args.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+	signed, err := s.key.SignTx(ctx, tx, args.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &signTransactionResult{Raw: raw, Tx: signed}, nil
+}
+
+// SignTypedData implements account_signTypedData.
+func (s *standInSigner) SignTypedData(
+	ctx context.Context,
+	_ common.MixedcaseAddress,
+	typedData apitypes.TypedData,
+) (hexutil.Bytes, error) {
+	return s.key.SignTypedData(ctx, typedData)
+}
+
+// SignData implements account_signData for text/plain personal messages.
+func (s *standInSigner) SignData(
+	ctx context.Context,
+	_ string,
+	_ common.MixedcaseAddress,
+	data hexutil.Bytes,
+) (hexutil.Bytes, error) {
+	return s.key.SignMessage(ctx, data)
+}
+
+// startStandInSigner serves a Clef stand-in for pk over HTTP and returns its endpoint.
+func startStandInSigner(t *testing.T, pk *ecdsa.PrivateKey) string {
+	t.Helper()
+	return startTamperingSigner(t, pk, nil)
+}
+
+// startTamperingSigner serves a Clef stand-in for pk altering the transactions it signs with tamper.
+func startTamperingSigner(t *testing.T, pk *ecdsa.PrivateKey, tamper func(args *apitypes.SendTxArgs)) string {
+	t.Helper()
+	key, err := signer.NewKeySigner(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := rpc.NewServer()
+	if err := server.RegisterName(signer.ClefNamespace, &standInSigner{key: key, tamper: tamper}); err != nil {
+		t.Fatal(err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httpServer.URL
+}
+
+func testTypedData() apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "Test", ChainId: math.NewHexOrDecimal256(hex.TestChainID)},
+		Message:     apitypes.TypedDataMessage{"contents": "hello"},
+	}
+}
+
+// Test_KeySigner verifies that hash and typed data signatures recover to the signer's address.
+func Test_KeySigner(t *testing.T) {
+	pk, _ := crypto.GenerateKey()
+	keySigner, err := signer.NewKeySigner(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, crypto.PubkeyToAddress(pk.PublicKey), keySigner.Address())
+
+	_, err = signer.NewKeySigner(nil)
+	assert.Error(t, err)
+
+	hash := crypto.Keccak256Hash([]byte("hello"))
+	sig, err := keySigner.SignHash(context.Background(), hash)
+	assert.Nil(t, err)
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	assert.Nil(t, err)
+	assert.Equal(t, keySigner.Address(), crypto.PubkeyToAddress(*pub))
+
+	typedData := testTypedData()
+	sig, err = keySigner.SignTypedData(context.Background(), typedData)
+	assert.Nil(t, err)
+	assert.Contains(t, []byte{27, 28}, sig[crypto.RecoveryIDOffset])
+
+	typedHash, _, err := apitypes.TypedDataAndHash(typedData)
+	assert.Nil(t, err)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pub, err = crypto.SigToPub(typedHash, sig)
+	assert.Nil(t, err)
+	assert.Equal(t, keySigner.Address(), crypto.PubkeyToAddress(*pub))
+
+	sig, err = keySigner.SignMessage(context.Background(), []byte("hello"))
+	assert.Nil(t, err)
+	assert.Contains(t, []byte{27, 28}, sig[crypto.RecoveryIDOffset])
+	sig[crypto.RecoveryIDOffset] -= 27
+	pub, err = crypto.SigToPub(accounts.TextHash([]byte("hello")), sig)
+	assert.Nil(t, err)
+	assert.Equal(t, keySigner.Address(), crypto.PubkeyToAddress(*pub))
+}
+
+// Test_KeystoreSigner verifies that an encrypted keystore file is decrypted into a signer.
+func Test_KeystoreSigner(t *testing.T) {
+	pk, _ := crypto.GenerateKey()
+	id, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &keystore.Key{Id: id, Address: crypto.PubkeyToAddress(pk.PublicKey), PrivateKey: pk}
+	keyJSON, err := keystore.EncryptKey(key, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.json")
+	if err := os.WriteFile(path, keyJSON, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Path          string
+		Passphrase    string
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:       "OK - Decrypt keystore",
+			Path:       path,
+			Passphrase: "secret",
+		},
+		{
+			Name:          "NOK - Wrong passphrase",
+			Path:          path,
+			Passphrase:    "wrong",
+			ExpectError:   true,
+			ExpectedError: "failed to decrypt keystore",
+		},
+		{
+			Name:          "NOK - Missing file",
+			Path:          filepath.Join(t.TempDir(), "missing.json"),
+			ExpectError:   true,
+			ExpectedError: "failed to read keystore file",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			keystoreSigner, err := signer.NewKeystoreSigner(tt.Path, tt.Passphrase)
+			if tt.ExpectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, key.Address, keystoreSigner.Address())
+		})
+	}
+}
+
+// Test_RemoteSigner verifies that interactions can send transactions signed by a remote Clef-like signer.
+func Test_RemoteSigner(t *testing.T) {
+	backend, _, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	endpoint := startStandInSigner(t, privKey)
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	remote, err := signer.DialRemoteSigner(context.Background(), endpoint, address, signer.ClefNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseInteractions := base.NewBaseInteractionsFromSigner(backend.Client(), remote, nil, false)
+	tx, err := baseInteractions.TransferETH(common.HexToAddress("0x1234"), big.NewInt(1e18))
+	if !assert.Nil(t, err) {
+		return
+	}
+	backend.Commit()
+
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	sig, err := remote.SignTypedData(context.Background(), testTypedData())
+	assert.Nil(t, err)
+	assert.Len(t, sig, crypto.SignatureLength)
+
+	sig, err = remote.SignMessage(context.Background(), []byte("hello"))
+	assert.Nil(t, err)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pub, err := crypto.SigToPub(accounts.TextHash([]byte("hello")), sig)
+	assert.Nil(t, err)
+	assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+
+	_, err = remote.SignHash(context.Background(), common.Hash{})
+	assert.ErrorIs(t, err, signer.ErrUnsupported)
+
+	// A remote signer answering for another account is rejected.
+	impostor, err := signer.DialRemoteSigner(
+		context.Background(), endpoint, common.HexToAddress("0x1234"), signer.ClefNamespace,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = impostor.SignTx(context.Background(), tx, big.NewInt(hex.TestChainID))
+	assert.Error(t, err)
+
+	// A remote signer altering the transaction is rejected.
+	tamperings := []struct {
+		Name          string
+		Tamper        func(args *apitypes.SendTxArgs)
+		ExpectedError string
+	}{
+		{
+			Name:          "NOK - Nonce changed",
+			Tamper:        func(args *apitypes.SendTxArgs) { args.Nonce++ },
+			ExpectedError: "nonce",
+		},
+		{
+			Name: "NOK - Recipient changed",
+			Tamper: func(args *apitypes.SendTxArgs) {
+				other := common.NewMixedcaseAddress(common.HexToAddress("0x5678"))
+				args.To = &other
+			},
+			ExpectedError: "recipient",
+		},
+		{
+			Name:          "NOK - Value changed",
+			Tamper:        func(args *apitypes.SendTxArgs) { args.Value = hexutil.Big(*big.NewInt(2e18)) },
+			ExpectedError: "value",
+		},
+		{
+			Name:          "NOK - Gas changed",
+			Tamper:        func(args *apitypes.SendTxArgs) { args.Gas++ },
+			ExpectedError: "gas",
+		},
+	}
+	for _, tt := range tamperings {
+		t.Run(tt.Name, func(t *testing.T) {
+			tampering, err := signer.DialRemoteSigner(
+				context.Background(), startTamperingSigner(t, privKey, tt.Tamper), address, signer.ClefNamespace,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = tampering.SignTx(context.Background(), tx, big.NewInt(hex.TestChainID))
+			assert.ErrorIs(t, err, signer.ErrTxMismatch)
+			assert.ErrorContains(t, err, tt.ExpectedError)
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// Package siwe builds, parses and verifies Sign-In with Ethereum messages (EIP-4361), signed as EIP-191
+// personal messages.
+package siwe
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Version is the only version of the message format.
+const Version = "1"
+
+// headerSuffix follows the domain on the first line of a message.
+const headerSuffix = " wants you to sign in with your Ethereum account:"
+
+// Field prefixes of a message, in the order they appear.
+const (
+	uriPrefix            = "URI: "
+	versionPrefix        = "Version: "
+	chainIDPrefix        = "Chain ID: "
+	noncePrefix          = "Nonce: "
+	issuedAtPrefix       = "Issued At: "
+	expirationTimePrefix = "Expiration Time: "
+	notBeforePrefix      = "Not Before: "
+	requestIDPrefix      = "Request ID: "
+	resourcesHeader      = "Resources:"
+	resourcePrefix       = "- "
+)
+
+// nonceAlphabet is the alphabet nonces are made of.
+const nonceAlphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// Nonce lengths: EIP-4361 requires at least 8 alphanumeric characters, generated ones carry over 95 bits.
+const (
+	minNonceLength = 8
+	nonceLength    = 17
+)
+
+// ErrInvalidMessage is returned when parsing text that is not a well-formed message.
+var ErrInvalidMessage = errors.New("invalid SIWE message")
+
+// Message is a Sign-In with Ethereum message. Zero times and empty strings are omitted optional fields.
+type Message struct {
+	// Scheme is the optional URI scheme of the origin of the request.
+	Scheme         string
+	Domain         string
+	Address        common.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        *big.Int
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime time.Time
+	NotBefore      time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// NewMessage returns a message asking address to sign in to domain for uri on the chain, issued now
+// with a random nonce.
+func NewMessage(domain string, address common.Address, uri string, chainID *big.Int) (*Message, error) {
+	if chainID == nil {
+		return nil, fmt.Errorf("%w: missing chain ID", ErrInvalidMessage)
+	}
+	nonce, err := GenerateNonce()
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		Domain:   domain,
+		Address:  address,
+		URI:      uri,
+		Version:  Version,
+		ChainID:  chainID,
+		Nonce:    nonce,
+		IssuedAt: time.Now().UTC(),
+	}, nil
+}
+
+// GenerateNonce returns a random alphanumeric nonce.
+func GenerateNonce() (string, error) {
+	nonce := make([]byte, nonceLength)
+	alphabetSize := big.NewInt(int64(len(nonceAlphabet)))
+	for idx := range nonce {
+		char, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate nonce: %w", err)
+		}
+		nonce[idx] = nonceAlphabet[char.Int64()]
+	}
+	return string(nonce), nil
+}
+
+// Text returns the text of the message, the one that is signed, after checking that Parse reads it back.
+func (m *Message) Text() (string, error) {
+	if m.ChainID == nil || m.ChainID.Sign() < 0 {
+		return "", fmt.Errorf("%w: invalid chain ID %v", ErrInvalidMessage, m.ChainID)
+	}
+	lines := []struct {
+		field, value string
+	}{
+		{"scheme", m.Scheme},
+		{"domain", m.Domain},
+		{"statement", m.Statement},
+		{"URI", m.URI},
+		{"request ID", m.RequestID},
+	}
+	for _, resource := range m.Resources {
+		lines = append(lines, struct{ field, value string }{"resource", resource})
+	}
+	for _, line := range lines {
+		if strings.ContainsAny(line.value, "\r\n") {
+			return "", fmt.Errorf("%w: %s %q spans several lines", ErrInvalidMessage, line.field, line.value)
+		}
+	}
+	text := m.String()
+	if _, err := Parse(text); err != nil {
+		return "", err
+	}
+	return text, nil
+}
+
+// String returns the text of the message without checking it is well-formed, see Text.
+func (m *Message) String() string {
+	var b strings.Builder
+	if m.Scheme != "" {
+		b.WriteString(m.Scheme + "://")
+	}
+	b.WriteString(m.Domain + headerSuffix + "\n")
+	b.WriteString(m.Address.Hex() + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+
+	b.WriteString(uriPrefix + m.URI + "\n")
+	b.WriteString(versionPrefix + m.Version + "\n")
+	b.WriteString(chainIDPrefix + m.ChainID.String() + "\n")
+	b.WriteString(noncePrefix + m.Nonce + "\n")
+	b.WriteString(issuedAtPrefix + m.IssuedAt.Format(time.RFC3339Nano))
+	if !m.ExpirationTime.IsZero() {
+		b.WriteString("\n" + expirationTimePrefix + m.ExpirationTime.Format(time.RFC3339Nano))
+	}
+	if !m.NotBefore.IsZero() {
+		b.WriteString("\n" + notBeforePrefix + m.NotBefore.Format(time.RFC3339Nano))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\n" + requestIDPrefix + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\n" + resourcesHeader)
+		for _, resource := range m.Resources {
+			b.WriteString("\n" + resourcePrefix + resource)
+		}
+	}
+	return b.String()
+}
+
+// Parse parses the text of a message, checking it is well-formed.
+func Parse(text string) (*Message, error) {
+	p := &parser{lines: strings.Split(text, "\n")}
+	m := &Message{}
+
+	header, ok := strings.CutSuffix(p.next(), headerSuffix)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidMessage)
+	}
+	if scheme, domain, ok := strings.Cut(header, "://"); ok {
+		m.Scheme, header = scheme, domain
+	}
+	if header == "" {
+		return nil, fmt.Errorf("%w: missing domain", ErrInvalidMessage)
+	}
+	m.Domain = header
+
+	address := p.next()
+	if !common.IsHexAddress(address) || common.HexToAddress(address).Hex() != address {
+		return nil, fmt.Errorf("%w: address %q is not EIP-55 checksummed", ErrInvalidMessage, address)
+	}
+	m.Address = common.HexToAddress(address)
+
+	if p.next() != "" {
+		return nil, fmt.Errorf("%w: missing empty line after address", ErrInvalidMessage)
+	}
+	if statement := p.next(); statement != "" {
+		m.Statement = statement
+		if p.next() != "" {
+			return nil, fmt.Errorf("%w: missing empty line after statement", ErrInvalidMessage)
+		}
+	}
+
+	var chainID, issuedAt string
+	required := []struct {
+		prefix string
+		value  *string
+	}{
+		{uriPrefix, &m.URI},
+		{versionPrefix, &m.Version},
+		{chainIDPrefix, &chainID},
+		{noncePrefix, &m.Nonce},
+		{issuedAtPrefix, &issuedAt},
+	}
+	for _, field := range required {
+		value, ok := p.field(field.prefix)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: missing %q", ErrInvalidMessage, strings.TrimSuffix(field.prefix, ": "))
+		}
+		*field.value = value
+	}
+
+	if m.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %q", ErrInvalidMessage, m.Version)
+	}
+	var isInt bool
+	if m.ChainID, isInt = new(big.Int).SetString(chainID, 10); !isInt || m.ChainID.Sign() < 0 {
+		return nil, fmt.Errorf("%w: invalid chain ID %q", ErrInvalidMessage, chainID)
+	}
+	if !validNonce(m.Nonce) {
+		return nil, fmt.Errorf("%w: nonce must be at least %d alphanumeric characters", ErrInvalidMessage, minNonceLength)
+	}
+	var err error
+	if m.IssuedAt, err = parseTime(issuedAt); err != nil {
+		return nil, err
+	}
+	if value, ok := p.field(expirationTimePrefix); ok {
+		if m.ExpirationTime, err = parseTime(value); err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := p.field(notBeforePrefix); ok {
+		if m.NotBefore, err = parseTime(value); err != nil {
+			return nil, err
+		}
+	}
+	m.RequestID, _ = p.field(requestIDPrefix)
+	if _, ok := p.field(resourcesHeader); ok {
+		for !p.done() {
+			resource, ok := p.field(resourcePrefix)
+			if !ok {
+				break
+			}
+			m.Resources = append(m.Resources, resource)
+		}
+	}
+
+	if !p.done() {
+		return nil, fmt.Errorf("%w: unexpected line %q", ErrInvalidMessage, p.lines[p.pos])
+	}
+	return m, nil
+}
+
+// parser reads the lines of a message.
+type parser struct {
+	lines []string
+	pos   int
+}
+
+// next returns the next line, empty past the end.
+func (p *parser) next() string {
+	if p.done() {
+		return ""
+	}
+	line := p.lines[p.pos]
+	p.pos++
+	return line
+}
+
+// field returns the value of the next line when it starts with prefix, consuming it.
+func (p *parser) field(prefix string) (string, bool) {
+	if p.done() {
+		return "", false
+	}
+	value, ok := strings.CutPrefix(p.lines[p.pos], prefix)
+	if !ok {
+		return "", false
+	}
+	p.pos++
+	return value, true
+}
+
+// done reports whether all lines were read.
+func (p *parser) done() bool {
+	return p.pos >= len(p.lines)
+}
+
+// parseTime parses an RFC 3339 timestamp.
+func parseTime(value string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid time %q", ErrInvalidMessage, value)
+	}
+	return parsed, nil
+}
+
+// validNonce reports whether the nonce is long enough and alphanumeric.
+func validNonce(nonce string) bool {
+	if len(nonce) < minNonceLength {
+		return false
+	}
+	for _, char := range nonce {
+		if !strings.ContainsRune(nonceAlphabet, char) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,255 @@
+package siwe_test
+
+// Package siwe_test contains tests for Sign-In with Ethereum messages.
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/siwe"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// specMessage is the example of the EIP-4361 specification.
+const specMessage = `example.com wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+I accept the ExampleOrg Terms of Service: https://example.com/tos
+
+URI: https://example.com/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+// Test_Parse verifies the parsing of messages and the rejection of malformed ones.
+func Test_Parse(t *testing.T) {
+	minimal := strings.Join([]string{
+		"https://example.com wants you to sign in with your Ethereum account:",
+		"0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+		"",
+		"",
+		"URI: https://example.com/login",
+		"Version: 1",
+		"Chain ID: 1",
+		"Nonce: 32891756",
+		"Issued At: 2021-09-30T16:25:24.123Z",
+		"Expiration Time: 2021-09-30T17:25:24Z",
+		"Not Before: 2021-09-30T16:25:24Z",
+		"Request ID: login-1",
+	}, "\n")
+
+	testCases := []struct {
+		Name          string
+		Text          string
+		Check         func(t *testing.T, message *siwe.Message)
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name: "OK - Specification example",
+			Text: specMessage,
+			Check: func(t *testing.T, message *siwe.Message) {
+				assert.Equal(t, "example.com", message.Domain)
+				assert.Equal(t, common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"), message.Address)
+				assert.Equal(t, "I accept the ExampleOrg Terms of Service: https://example.com/tos", message.Statement)
+				assert.Equal(t, "https://example.com/login", message.URI)
+				assert.Equal(t, int64(1), message.ChainID.Int64())
+				assert.Equal(t, "32891756", message.Nonce)
+				assert.Equal(t, time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC), message.IssuedAt)
+				assert.True(t, message.ExpirationTime.IsZero())
+				assert.Len(t, message.Resources, 2)
+			},
+		},
+		{
+			Name: "OK - Scheme, no statement and optional fields",
+			Text: minimal,
+			Check: func(t *testing.T, message *siwe.Message) {
+				assert.Equal(t, "https", message.Scheme)
+				assert.Equal(t, "example.com", message.Domain)
+				assert.Empty(t, message.Statement)
+				assert.Equal(t, 123*time.Millisecond, time.Duration(message.IssuedAt.Nanosecond()))
+				assert.Equal(t, time.Date(2021, 9, 30, 17, 25, 24, 0, time.UTC), message.ExpirationTime)
+				assert.Equal(t, "login-1", message.RequestID)
+				assert.Nil(t, message.Resources)
+			},
+		},
+		{
+			Name:          "NOK - Missing header",
+			Text:          strings.Replace(specMessage, " wants you", " asks you", 1),
+			ExpectError:   true,
+			ExpectedError: "invalid SIWE message: missing header",
+		},
+		{
+			Name: "NOK - Address not checksummed",
+			Text: strings.Replace(
+				specMessage, "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", 1,
+			),
+			ExpectError:   true,
+			ExpectedError: "is not EIP-55 checksummed",
+		},
+		{
+			Name:          "NOK - Missing nonce",
+			Text:          strings.Replace(specMessage, "Nonce: 32891756\n", "", 1),
+			ExpectError:   true,
+			ExpectedError: `missing "Nonce"`,
+		},
+		{
+			Name:          "NOK - Short nonce",
+			Text:          strings.Replace(specMessage, "Nonce: 32891756", "Nonce: 1234", 1),
+			ExpectError:   true,
+			ExpectedError: "nonce must be at least 8 alphanumeric characters",
+		},
+		{
+			Name:          "NOK - Unsupported version",
+			Text:          strings.Replace(specMessage, "Version: 1", "Version: 2", 1),
+			ExpectError:   true,
+			ExpectedError: `unsupported version "2"`,
+		},
+		{
+			Name:          "NOK - Invalid chain ID",
+			Text:          strings.Replace(specMessage, "Chain ID: 1", "Chain ID: one", 1),
+			ExpectError:   true,
+			ExpectedError: `invalid chain ID "one"`,
+		},
+		{
+			Name:          "NOK - Invalid time",
+			Text:          strings.Replace(specMessage, "2021-09-30T16:25:24Z", "yesterday", 1),
+			ExpectError:   true,
+			ExpectedError: `invalid time "yesterday"`,
+		},
+		{
+			Name:          "NOK - Trailing line",
+			Text:          specMessage + "\nSigned by: me",
+			ExpectError:   true,
+			ExpectedError: `unexpected line "Signed by: me"`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			message, err := siwe.Parse(tt.Text)
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.ErrorIs(t, err, siwe.ErrInvalidMessage)
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+				return
+			}
+			if !assert.Nil(t, err) {
+				return
+			}
+			tt.Check(t, message)
+			assert.Equal(t, tt.Text, message.String(), "parsed message must format to its text")
+		})
+	}
+}
+
+// Test_NewMessage verifies that built messages are well-formed with unique nonces.
+func Test_NewMessage(t *testing.T) {
+	address := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	message, err := siwe.NewMessage("example.com", address, "https://example.com/login", big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message.Statement = "Sign in to Example"
+	message.ExpirationTime = message.IssuedAt.Add(time.Hour)
+	message.Resources = []string{"https://example.com/terms"}
+
+	text, err := message.Text()
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, message.String(), text)
+	parsed, err := siwe.Parse(text)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, siwe.Version, parsed.Version)
+	assert.Equal(t, message.Nonce, parsed.Nonce)
+	assert.Equal(t, int64(1337), parsed.ChainID.Int64())
+	assert.True(t, message.IssuedAt.Equal(parsed.IssuedAt))
+	assert.True(t, message.ExpirationTime.Equal(parsed.ExpirationTime))
+	assert.Equal(t, message.Resources, parsed.Resources)
+
+	other, err := siwe.GenerateNonce()
+	assert.Nil(t, err)
+	assert.NotEqual(t, message.Nonce, other)
+	assert.Len(t, other, 17)
+}
+
+// Test_Text verifies that messages Parse could not read back are not rendered.
+func Test_Text(t *testing.T) {
+	address := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	_, err := siwe.NewMessage("example.com", address, "https://example.com/login", nil)
+	assert.ErrorIs(t, err, siwe.ErrInvalidMessage)
+
+	testCases := []struct {
+		Name          string
+		Update        func(message *siwe.Message)
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:   "OK - Single line statement",
+			Update: func(message *siwe.Message) { message.Statement = "Sign in to Example" },
+		},
+		{
+			Name:          "NOK - Missing chain ID",
+			Update:        func(message *siwe.Message) { message.ChainID = nil },
+			ExpectError:   true,
+			ExpectedError: "invalid chain ID <nil>",
+		},
+		{
+			Name:          "NOK - Negative chain ID",
+			Update:        func(message *siwe.Message) { message.ChainID = big.NewInt(-1) },
+			ExpectError:   true,
+			ExpectedError: "invalid chain ID -1",
+		},
+		{
+			Name:          "NOK - Multi-line statement",
+			Update:        func(message *siwe.Message) { message.Statement = "Sign in\n\nto Example" },
+			ExpectError:   true,
+			ExpectedError: "statement \"Sign in\\n\\nto Example\" spans several lines",
+		},
+		{
+			Name:          "NOK - Multi-line resource",
+			Update:        func(message *siwe.Message) { message.Resources = []string{"https://example.com\r\n"} },
+			ExpectError:   true,
+			ExpectedError: "spans several lines",
+		},
+		{
+			Name:          "NOK - Short nonce",
+			Update:        func(message *siwe.Message) { message.Nonce = "1234" },
+			ExpectError:   true,
+			ExpectedError: "nonce must be at least 8 alphanumeric characters",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			message, err := siwe.NewMessage("example.com", address, "https://example.com/login", big.NewInt(1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.Update(message)
+			text, err := message.Text()
+			if tt.ExpectError {
+				assert.ErrorIs(t, err, siwe.ErrInvalidMessage)
+				assert.ErrorContains(t, err, tt.ExpectedError)
+				assert.Empty(t, text)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, message.String(), text)
+		})
+	}
+}
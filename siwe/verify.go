@@ -0,0 +1,94 @@
+package siwe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/eip712"
+)
+
+var (
+	// ErrDomainMismatch is returned for messages asking to sign in to another domain.
+	ErrDomainMismatch = errors.New("SIWE domain mismatch")
+	// ErrNonceMismatch is returned for messages carrying another nonce than the one issued.
+	ErrNonceMismatch = errors.New("SIWE nonce mismatch")
+	// ErrChainIDMismatch is returned for messages signed for another chain.
+	ErrChainIDMismatch = errors.New("SIWE chain ID mismatch")
+	// ErrMissingChainID is returned for expectations naming no chain without accepting any.
+	ErrMissingChainID = errors.New("SIWE expected chain ID missing")
+	// ErrExpired is returned for messages past their expiration time.
+	ErrExpired = errors.New("SIWE message expired")
+	// ErrNotYetValid is returned for messages before their not-before time.
+	ErrNotYetValid = errors.New("SIWE message not yet valid")
+)
+
+// Expectations are the values a message must match to be accepted.
+type Expectations struct {
+	// Domain is the domain the message must ask to sign in to.
+	Domain string
+	// Nonce is the nonce issued for the sign-in, which must be used only once.
+	Nonce string
+	// ChainID is the chain the message must be signed for, required unless AnyChain is set.
+	ChainID *big.Int
+	// AnyChain accepts messages signed for any chain when ChainID is nil.
+	AnyChain bool
+	// Time is the time the validity period is checked at, now when zero.
+	Time time.Time
+}
+
+// Validate checks the message against the expectations.
+func (m *Message) Validate(expected Expectations) error {
+	if m.Domain != expected.Domain {
+		return fmt.Errorf("%w: expected %q, got %q", ErrDomainMismatch, expected.Domain, m.Domain)
+	}
+	if m.Nonce != expected.Nonce {
+		return fmt.Errorf("%w: expected %q, got %q", ErrNonceMismatch, expected.Nonce, m.Nonce)
+	}
+	switch {
+	case expected.ChainID == nil && !expected.AnyChain:
+		return ErrMissingChainID
+	case expected.ChainID != nil && (m.ChainID == nil || m.ChainID.Cmp(expected.ChainID) != 0):
+		return fmt.Errorf("%w: expected %s, got %s", ErrChainIDMismatch, expected.ChainID, m.ChainID)
+	}
+
+	now := expected.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if !m.ExpirationTime.IsZero() && !now.Before(m.ExpirationTime) {
+		return fmt.Errorf("%w at %s", ErrExpired, m.ExpirationTime.Format(time.RFC3339))
+	}
+	if !m.NotBefore.IsZero() && now.Before(m.NotBefore) {
+		return fmt.Errorf("%w before %s", ErrNotYetValid, m.NotBefore.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Verify parses the message, validates it against the expectations and checks it was signed by its
+// address, through ERC-1271 when the address is a contract. It returns the message on success.
+func Verify(
+	ctx context.Context,
+	client eip712.Client,
+	text string,
+	signature []byte,
+	expected Expectations,
+) (*Message, error) {
+	message, err := Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if err := message.Validate(expected); err != nil {
+		return nil, err
+	}
+	valid, err := eip712.VerifyPersonal(ctx, client, message.Address, []byte(text), signature)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, fmt.Errorf("%w: not signed by %s", eip712.ErrInvalidSignature, message.Address.Hex())
+	}
+	return message, nil
+}
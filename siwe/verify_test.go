@@ -0,0 +1,212 @@
+package siwe_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/base"
+	"github.com/Thektonic/eth-interfaces/eip712"
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/siwe"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Verify verifies sign-ins of accounts and smart-contract wallets and the rejection of invalid ones.
+func Test_Verify(t *testing.T) {
+	backend, auth, _, privKey, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil, false)
+	chainID := big.NewInt(hex.TestChainID)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	newMessage := func(address common.Address) *siwe.Message {
+		message, err := siwe.NewMessage("example.com", address, "https://example.com/login", chainID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		message.IssuedAt = now
+		message.ExpirationTime = now.Add(time.Hour)
+		message.NotBefore = now.Add(-time.Minute)
+		return message
+	}
+	message := newMessage(baseInteractions.Address)
+	text, err := message.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := baseInteractions.SignMessage([]byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The wallet approves the message naming it, so its address is predicted before deploying it.
+	nonce, err := backend.Client().PendingNonceAt(context.Background(), auth.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	walletMessage := newMessage(crypto.CreateAddress(auth.From, nonce))
+	walletText := walletMessage.String()
+	wallet, err := testingtools.DeployERC1271Wallet(auth, backend, eip712.HashPersonal([]byte(walletText)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, walletMessage.Address, *wallet)
+
+	expected := siwe.Expectations{Domain: "example.com", Nonce: message.Nonce, ChainID: chainID, Time: now}
+	tampered := bytes.Clone(signature)
+	tampered[0] ^= 0xff
+
+	testCases := []struct {
+		Name          string
+		Text          string
+		Signature     []byte
+		Expected      func(siwe.Expectations) siwe.Expectations
+		ExpectedError error
+	}{
+		{
+			Name:      "OK - Signed by the account",
+			Text:      message.String(),
+			Signature: signature,
+		},
+		{
+			Name:      "OK - Approved by the wallet",
+			Text:      walletText,
+			Signature: []byte{0x01},
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.Nonce = walletMessage.Nonce
+				return e
+			},
+		},
+		{
+			Name:      "NOK - Other domain",
+			Text:      message.String(),
+			Signature: signature,
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.Domain = "evil.com"
+				return e
+			},
+			ExpectedError: siwe.ErrDomainMismatch,
+		},
+		{
+			Name:      "NOK - Other nonce",
+			Text:      message.String(),
+			Signature: signature,
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.Nonce = "reissued1"
+				return e
+			},
+			ExpectedError: siwe.ErrNonceMismatch,
+		},
+		{
+			Name:      "NOK - Other chain",
+			Text:      message.String(),
+			Signature: signature,
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.ChainID = big.NewInt(1)
+				return e
+			},
+			ExpectedError: siwe.ErrChainIDMismatch,
+		},
+		{
+			Name:      "OK - Any chain",
+			Text:      message.String(),
+			Signature: signature,
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.ChainID, e.AnyChain = nil, true
+				return e
+			},
+		},
+		{
+			Name:      "NOK - Missing expected chain",
+			Text:      message.String(),
+			Signature: signature,
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.ChainID = nil
+				return e
+			},
+			ExpectedError: siwe.ErrMissingChainID,
+		},
+		{
+			Name:      "NOK - Expired",
+			Text:      message.String(),
+			Signature: signature,
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.Time = now.Add(time.Hour)
+				return e
+			},
+			ExpectedError: siwe.ErrExpired,
+		},
+		{
+			Name:      "NOK - Not yet valid",
+			Text:      message.String(),
+			Signature: signature,
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.Time = now.Add(-time.Hour)
+				return e
+			},
+			ExpectedError: siwe.ErrNotYetValid,
+		},
+		{
+			Name:          "NOK - Tampered signature",
+			Text:          message.String(),
+			Signature:     tampered,
+			ExpectedError: eip712.ErrInvalidSignature,
+		},
+		{
+			Name:      "NOK - Wallet rejecting the message",
+			Text:      walletText + "\nResources:\n- https://example.com/admin",
+			Signature: []byte{0x01},
+			Expected: func(e siwe.Expectations) siwe.Expectations {
+				e.Nonce = walletMessage.Nonce
+				return e
+			},
+			ExpectedError: eip712.ErrInvalidSignature,
+		},
+		{
+			Name:          "NOK - Malformed message",
+			Text:          "sign me in",
+			Signature:     signature,
+			ExpectedError: siwe.ErrInvalidMessage,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			expectations := expected
+			if tt.Expected != nil {
+				expectations = tt.Expected(expected)
+			}
+			verified, err := siwe.Verify(context.Background(), backend.Client(), tt.Text, tt.Signature, expectations)
+			if tt.ExpectedError != nil {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.True(t, errors.Is(err, tt.ExpectedError), "unexpected error: %v", err)
+				return
+			}
+			if assert.Nil(t, err) {
+				assert.Equal(t, tt.Text, verified.String())
+			}
+		})
+	}
+}
@@ -7,13 +7,19 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // SetupBlockchain sets up a test blockchain environment with a deployed contract
@@ -81,3 +87,180 @@ func DeployEmptyContract(auth *bind.TransactOpts, backend *simulated.Backend) (*
 	}
 	return &contractAddr, nil
 }
+
+// multicall3Bin is the creation code of a minimal, hand-assembled stand-in for Multicall3 implementing
+// aggregate3 only: calls are executed in order, failures revert unless allowFailure is set.
+const multicall3Bin = "0x6100c780600c6000396000f360003560e01c6382ad56cb146100155760006000fd5b6004356004018035602060" +
+	"0052806020528060051b60400160005b8083146100c257604082038160051b604001528060051b840160200135840160" +
+	"200180604001358101803580826020018660600137600060008287606001600087355af1806100885783602001356100" +
+	"885760006000fd5b8552505050604082602001523d82604001523d6000836060013e60003d8301606001523d601f0160" +
+	"1f191660600182019150600101610030565b506000f3"
+
+// DeployMulticall3 deploys a contract exposing the Multicall3 aggregate3 function for testing purposes
+func DeployMulticall3(auth *bind.TransactOpts, backend *simulated.Backend) (*common.Address, error) {
+	contractAddr, tx, _, err := hex.DeployContract(
+		auth,
+		backend.Client(),
+		inferences.Multicall3MetaData.ABI,
+		multicall3Bin,
+	)
+	if err != nil {
+		return nil, err
+	}
+	backend.Commit()
+
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil || receipt.Status != 1 {
+		return nil, fmt.Errorf("multicall3 deployment failed: %w", err)
+	}
+	return &contractAddr, nil
+}
+
+// callProxy serves eth_call requests from a simulated backend, whose client does not expose its rpc.Client.
+type callProxy struct {
+	client simulated.Client
+}
+
+// callArgs holds the eth_call transaction argument fields used by the contract calls.
+type callArgs struct {
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"`
+	Input hexutil.Bytes   `json:"input"`
+	Value *hexutil.Big    `json:"value"`
+}
+
+// Call executes the call against the requested block.
+func (p *callProxy) Call(ctx context.Context, args callArgs, block rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	msg := ethereum.CallMsg{From: args.From, To: args.To, Data: args.Input, Value: args.Value.ToInt()}
+	if hash, ok := block.Hash(); ok {
+		caller, ok := p.client.(interface {
+			CallContractAtHash(context.Context, ethereum.CallMsg, common.Hash) ([]byte, error)
+		})
+		if !ok {
+			return nil, fmt.Errorf("calls at block hash %s are not supported", hash.Hex())
+		}
+		return caller.CallContractAtHash(ctx, msg, hash)
+	}
+	number, _ := block.Number()
+	switch number {
+	case rpc.PendingBlockNumber:
+		return p.client.PendingCallContract(ctx, msg)
+	case rpc.LatestBlockNumber:
+		return p.client.CallContract(ctx, msg, nil)
+	default:
+		return p.client.CallContract(ctx, msg, big.NewInt(number.Int64()))
+	}
+}
+
+// NewCallProxy returns an in-process rpc.Client serving eth_call requests, including batched ones,
+// from the simulated backend.
+func NewCallProxy(backend *simulated.Backend) (*rpc.Client, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &callProxy{client: backend.Client()}); err != nil {
+		return nil, err
+	}
+	return rpc.DialInProc(server), nil
+}
+
+// DeployDisperse deploys the bundled Disperse contract for testing purposes
+func DeployDisperse(auth *bind.TransactOpts, backend *simulated.Backend) (*common.Address, error) {
+	contractAddr, tx, _, err := hex.DeployContract(
+		auth,
+		backend.Client(),
+		inferences.DisperseMetaData.ABI,
+		inferences.DisperseMetaData.Bin,
+	)
+	if err != nil {
+		return nil, err
+	}
+	backend.Commit()
+
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil || receipt.Status != 1 {
+		return nil, fmt.Errorf("disperse deployment failed: %w", err)
+	}
+	return &contractAddr, nil
+}
+
+// ReturningCode returns code copying the data following it to memory and returning it, for code placed
+// at offset within the deployed bytes.
+func ReturningCode(offset int, data []byte) []byte {
+	// PUSH2 size DUP1 PUSH2 start PUSH0 CODECOPY PUSH0 RETURN
+	const headerLength = 11
+	start := offset + headerLength
+	header := []byte{
+		byte(vm.PUSH2), byte(len(data) >> 8), byte(len(data)), byte(vm.DUP1),
+		byte(vm.PUSH2), byte(start >> 8), byte(start), byte(vm.PUSH0), byte(vm.CODECOPY),
+		byte(vm.PUSH0), byte(vm.RETURN),
+	}
+	return append(header, data...)
+}
+
+// DeployRuntime deploys the runtime code with the given storage slots holding addresses for testing purposes
+func DeployRuntime(
+	auth *bind.TransactOpts,
+	backend *simulated.Backend,
+	runtime []byte,
+	storage map[common.Hash]common.Address,
+) (*common.Address, error) {
+	var creation []byte
+	for slot, value := range storage {
+		creation = append(creation, byte(vm.PUSH32))
+		creation = append(creation, common.BytesToHash(value.Bytes()).Bytes()...)
+		creation = append(creation, byte(vm.PUSH32))
+		creation = append(creation, slot.Bytes()...)
+		creation = append(creation, byte(vm.SSTORE))
+	}
+	creation = append(creation, ReturningCode(len(creation), runtime)...)
+
+	contractAddr, tx, _, err := hex.DeployContract(auth, backend.Client(), "[]", common.Bytes2Hex(creation))
+	if err != nil {
+		return nil, err
+	}
+	backend.Commit()
+
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil || receipt.Status != 1 {
+		return nil, fmt.Errorf("runtime deployment failed: %w", err)
+	}
+	return &contractAddr, nil
+}
+
+// DeployERC1271Wallet deploys a smart-contract wallet whose ERC-1271 isValidSignature accepts any
+// signature of the approved hash only, for testing purposes
+func DeployERC1271Wallet(
+	auth *bind.TransactOpts,
+	backend *simulated.Backend,
+	approved common.Hash,
+) (*common.Address, error) {
+	// PUSH32 approved PUSH1 4 CALLDATALOAD EQ PUSH1 valid JUMPI
+	// PUSH4 0xffffffff PUSH1 224 SHL PUSH0 MSTORE PUSH1 32 PUSH0 RETURN
+	// valid: JUMPDEST PUSH4 0x1626ba7e PUSH1 224 SHL PUSH0 MSTORE PUSH1 32 PUSH0 RETURN
+	runtime := append([]byte{byte(vm.PUSH32)}, approved.Bytes()...)
+	runtime = append(runtime, common.FromHex("600435146036576"+
+		"3ffffffff60e01b5f5260205ff35b631626ba7e60e01b5f5260205ff3")...)
+	return DeployRuntime(auth, backend, runtime, nil)
+}
+
+// AutoCommit commits a block on the simulated backend at every interval until the returned function is called.
+func AutoCommit(backend *simulated.Backend, interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				backend.Commit()
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
@@ -2,6 +2,8 @@
 package transaction
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	bind2 "github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
 )
@@ -18,7 +20,34 @@ type Interaction interface {
 	Safe() bool
 }
 
+// NonceTracker is implemented by interactions that hand out nonces locally and must be told when
+// a transaction built from BaseTxSetup options failed before being broadcast.
+type NonceTracker interface {
+	ReleaseNonce(nonce uint64, err error)
+}
+
+// ContextInteraction is implemented by interactions able to set up transaction options with a
+// caller-provided context, used for the nonce, fee and chain ID lookups as well as for signing.
+type ContextInteraction interface {
+	BaseTxSetupCtx(ctx context.Context) (*bind.TransactOpts, error)
+}
+
 // Call performs a call to the contract using the provided session and calldata, returning the unpacked result.
 func Call[T any](s Session, calldata []byte, unpack func([]byte) (T, error)) (T, error) {
 	return bind2.Call(s.Instance(), s.CallOpts(), calldata, unpack)
 }
+
+// CallCtx performs a call like Call, running it with the given context.
+func CallCtx[T any](ctx context.Context, s Session, calldata []byte, unpack func([]byte) (T, error)) (T, error) {
+	return bind2.Call(s.Instance(), withContext(ctx, s.CallOpts()), calldata, unpack)
+}
+
+// withContext returns a copy of the call options running with ctx.
+func withContext(ctx context.Context, opts *bind.CallOpts) *bind.CallOpts {
+	callOpts := bind.CallOpts{}
+	if opts != nil {
+		callOpts = *opts
+	}
+	callOpts.Context = ctx
+	return &callOpts
+}
@@ -0,0 +1,187 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultPageBlockRange is the number of blocks scanned per query when paginating logs.
+const DefaultPageBlockRange = 5_000
+
+// LogReader is implemented by clients able to query logs.
+type LogReader interface {
+	ethereum.LogFilterer
+	ethereum.BlockNumberReader
+}
+
+// rangeErrorMessages are fragments of the errors nodes return when a log query spans too many
+// blocks or matches too many logs.
+var rangeErrorMessages = []string{
+	"query returned more than",
+	"block range",
+	"range too large",
+	"range is too large",
+	"too many blocks",
+	"too many results",
+	"limit exceeded",
+	"response size exceeded",
+	"query timeout exceeded",
+}
+
+// IsRangeError reports whether err is a node rejecting a log query for its range or result size.
+func IsRangeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	for _, fragment := range rangeErrorMessages {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// LogCursor is the position of a log in the chain. A page query started from a cursor returns
+// the logs at or after it.
+type LogCursor struct {
+	Block uint64
+	Index uint
+}
+
+// after returns the cursor following the log.
+func after(log types.Log) LogCursor {
+	return LogCursor{Block: log.BlockNumber, Index: log.Index + 1}
+}
+
+// FilterLogs runs the query between its FromBlock, the genesis when nil, and its ToBlock, the latest
+// block when nil. Ranges rejected by the node are split in halves until they are accepted.
+func FilterLogs(ctx context.Context, client LogReader, query ethereum.FilterQuery) ([]types.Log, error) {
+	from, to, err := blockRange(ctx, client, query)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, nil
+	}
+	return filterRange(ctx, client, query, from, to)
+}
+
+// FilterLogsPage returns at most limit logs matching the query, starting from cursor, and the cursor
+// to resume from. The returned cursor is nil once the query's ToBlock, the latest block when nil, is
+// reached. The query's FromBlock is ignored.
+func FilterLogsPage(
+	ctx context.Context,
+	client LogReader,
+	query ethereum.FilterQuery,
+	cursor LogCursor,
+	limit int,
+) ([]types.Log, *LogCursor, error) {
+	if limit < 1 {
+		return nil, nil, fmt.Errorf("invalid page limit %d", limit)
+	}
+	query.FromBlock = new(big.Int).SetUint64(cursor.Block)
+	_, to, err := blockRange(ctx, client, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var logs []types.Log
+	for start := cursor.Block; start <= to; start += DefaultPageBlockRange {
+		end := min(start+DefaultPageBlockRange-1, to)
+		found, err := filterRange(ctx, client, query, start, end)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, log := range found {
+			if log.BlockNumber == cursor.Block && log.Index < cursor.Index {
+				continue
+			}
+			logs = append(logs, log)
+			if len(logs) == limit {
+				next := after(log)
+				return logs, &next, nil
+			}
+		}
+	}
+	return logs, nil, nil
+}
+
+// DecodeLogs unpacks the logs into their event structs.
+func DecodeLogs[T any](logs []types.Log, unpack func(*types.Log) (T, error)) ([]T, error) {
+	events := make([]T, 0, len(logs))
+	for idx := range logs {
+		event, err := unpack(&logs[idx])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode log %d of transaction %s: %w",
+				logs[idx].Index, logs[idx].TxHash.Hex(), err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// AddressTopics returns the topics matching any of the addresses, as indexed address event parameters,
+// nil matching any topic.
+func AddressTopics(addresses []common.Address) []common.Hash {
+	if len(addresses) == 0 {
+		return nil
+	}
+	topics := make([]common.Hash, len(addresses))
+	for idx, address := range addresses {
+		topics[idx] = common.BytesToHash(address.Bytes())
+	}
+	return topics
+}
+
+// blockRange resolves the block range of the query.
+func blockRange(ctx context.Context, client LogReader, query ethereum.FilterQuery) (uint64, uint64, error) {
+	if query.BlockHash != nil {
+		return 0, 0, errors.New("block hash queries cannot be split")
+	}
+	var from uint64
+	if query.FromBlock != nil {
+		from = query.FromBlock.Uint64()
+	}
+	if query.ToBlock != nil {
+		return from, query.ToBlock.Uint64(), nil
+	}
+	latest, err := client.BlockNumber(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return from, latest, nil
+}
+
+// filterRange queries the logs from block from to block to, splitting the range when the node rejects it.
+func filterRange(
+	ctx context.Context,
+	client LogReader,
+	query ethereum.FilterQuery,
+	from, to uint64,
+) ([]types.Log, error) {
+	query.FromBlock = new(big.Int).SetUint64(from)
+	query.ToBlock = new(big.Int).SetUint64(to)
+	logs, err := client.FilterLogs(ctx, query)
+	if err == nil || !IsRangeError(err) || from == to {
+		return logs, err
+	}
+
+	middle := from + (to-from)/2
+	first, err := filterRange(ctx, client, query, from, middle)
+	if err != nil {
+		return nil, err
+	}
+	second, err := filterRange(ctx, client, query, middle+1, to)
+	if err != nil {
+		return nil, err
+	}
+	return append(first, second...), nil
+}
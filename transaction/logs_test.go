@@ -0,0 +1,138 @@
+package transaction_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum"
+	bind2 "github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// rangeLimitedClient rejects the log queries spanning more than maxRange blocks, like public nodes do.
+type rangeLimitedClient struct {
+	transaction.LogReader
+	maxRange uint64
+	err      error
+	queries  int
+}
+
+func (c *rangeLimitedClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	c.queries++
+	if c.err != nil {
+		return nil, c.err
+	}
+	if query.ToBlock.Uint64()-query.FromBlock.Uint64() >= c.maxRange {
+		return nil, errors.New("query returned more than 10000 results")
+	}
+	return c.LogReader.FilterLogs(ctx, query)
+}
+
+// Test_FilterLogs verifies that rejected ranges are split and that pages cover the whole range.
+func Test_FilterLogs(t *testing.T) {
+	backend, auth, contractAddress, _, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	// One block with two transfers, then one transfer per block.
+	ierc20 := inferences.NewIerc20()
+	instance := ierc20.Instance(backend.Client(), *contractAddress)
+	for idx := range 7 {
+		to := common.BigToAddress(big.NewInt(int64(0x1000 + idx)))
+		if _, err := bind2.Transact(instance, auth, ierc20.PackTransfer(to, big.NewInt(1))); err != nil {
+			t.Fatal(err)
+		}
+		if idx != 0 {
+			backend.Commit()
+		}
+	}
+
+	query := ethereum.FilterQuery{Addresses: []common.Address{*contractAddress}}
+	expected, err := backend.Client().FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: common.Big0,
+		Addresses: []common.Address{*contractAddress},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The deployment emits the minting transfer.
+	assert.Equal(t, 8, len(expected))
+
+	testCases := []struct {
+		Name          string
+		MaxRange      uint64
+		Err           error
+		ExpectError   bool
+		ExpectedSplit bool
+	}{
+		{
+			Name:     "OK - Range accepted",
+			MaxRange: 1_000,
+		},
+		{
+			Name:          "OK - Range split",
+			MaxRange:      2,
+			ExpectedSplit: true,
+		},
+		{
+			Name:        "KO - Node error",
+			MaxRange:    1_000,
+			Err:         errors.New("connection refused"),
+			ExpectError: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			client := &rangeLimitedClient{LogReader: backend.Client(), maxRange: tt.MaxRange, err: tt.Err}
+			logs, err := transaction.FilterLogs(context.Background(), client, query)
+			if tt.ExpectError {
+				assert.ErrorIs(t, err, tt.Err)
+				assert.Equal(t, 1, client.queries)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, expected, logs)
+			assert.Equal(t, tt.ExpectedSplit, client.queries > 1)
+
+			var paged []types.Log
+			cursor := &transaction.LogCursor{}
+			for cursor != nil {
+				var page []types.Log
+				page, cursor, err = transaction.FilterLogsPage(context.Background(), client, query, *cursor, 3)
+				if !assert.Nil(t, err) {
+					return
+				}
+				assert.LessOrEqual(t, len(page), 3)
+				paged = append(paged, page...)
+			}
+			assert.Equal(t, expected, paged)
+		})
+	}
+
+	assert.True(t, transaction.IsRangeError(errors.New("eth_getLogs block range is too large")))
+	assert.False(t, transaction.IsRangeError(errors.New("connection refused")))
+}
+
+// Test_AddressTopics verifies that addresses are left-padded into topics and that no address matches any.
+func Test_AddressTopics(t *testing.T) {
+	assert.Nil(t, transaction.AddressTopics(nil))
+	topics := transaction.AddressTopics([]common.Address{common.HexToAddress("0x1234")})
+	assert.Equal(t, []common.Hash{common.HexToHash("0x1234")}, topics)
+}
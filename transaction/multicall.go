@@ -0,0 +1,149 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DefaultMulticallChunkSize is the default number of calls aggregated in a single Multicall3 call.
+const DefaultMulticallChunkSize = 500
+
+// revertErrorCode is the JSON-RPC error code nodes use for reverted calls.
+const revertErrorCode = 3
+
+// Multicall3Address is the address Multicall3 is deployed at on most chains.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// ErrPendingResult is returned by results read before the multicall was executed.
+var ErrPendingResult = errors.New("multicall not executed")
+
+// CallFailedError is the error of a call that failed inside a multicall. It exposes the revert
+// data the same way nodes do, so that ethclient.RevertErrorData and the call error handlers decode it.
+type CallFailedError struct {
+	Target     common.Address
+	ReturnData []byte
+}
+
+func (e *CallFailedError) Error() string {
+	return fmt.Sprintf("call to %s failed", e.Target.Hex())
+}
+
+// ErrorCode returns the JSON-RPC error code of reverted calls.
+func (e *CallFailedError) ErrorCode() int { return revertErrorCode }
+
+// ErrorData returns the hex encoded revert data.
+func (e *CallFailedError) ErrorData() interface{} { return hexutil.Encode(e.ReturnData) }
+
+// Result holds the outcome of a call queued in a Multicall, available once the multicall was executed.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Get returns the unpacked value of the call or its error.
+func (r *Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Multicall aggregates read calls into Multicall3 aggregate3 calls, executed in chunks.
+type Multicall struct {
+	multicall *inferences.Multicall3
+	instance  *bind.BoundContract
+	callOpts  *bind.CallOpts
+	chunkSize int
+	calls     []inferences.Multicall3Call3
+	handlers  []func(success bool, data []byte)
+}
+
+// NewMulticall creates a batcher calling the Multicall3 contract at address with the given call options.
+// A chunkSize lower than 1 defaults to DefaultMulticallChunkSize.
+func NewMulticall(
+	backend bind.ContractBackend,
+	address common.Address,
+	callOpts *bind.CallOpts,
+	chunkSize int,
+) *Multicall {
+	if chunkSize < 1 {
+		chunkSize = DefaultMulticallChunkSize
+	}
+	multicall := inferences.NewMulticall3()
+	return &Multicall{
+		multicall: multicall,
+		instance:  multicall.Instance(backend, address),
+		callOpts:  callOpts,
+		chunkSize: chunkSize,
+	}
+}
+
+// AddCall queues a call to target. Unless allowFailure is set, a failing call makes the whole
+// chunk it belongs to revert.
+func AddCall[T any](
+	m *Multicall,
+	target common.Address,
+	calldata []byte,
+	unpack func([]byte) (T, error),
+	allowFailure bool,
+) *Result[T] {
+	result := &Result[T]{err: ErrPendingResult}
+	m.calls = append(m.calls, inferences.Multicall3Call3{
+		Target:       target,
+		AllowFailure: allowFailure,
+		CallData:     calldata,
+	})
+	m.handlers = append(m.handlers, func(success bool, data []byte) {
+		if !success {
+			result.err = &CallFailedError{Target: target, ReturnData: data}
+			return
+		}
+		result.value, result.err = unpack(data)
+	})
+	return result
+}
+
+// Len returns the number of queued calls.
+func (m *Multicall) Len() int {
+	return len(m.calls)
+}
+
+// Execute runs the queued calls chunk by chunk with ctx and fills their results. The queue is emptied
+// even when a chunk fails, in which case the results of that chunk and the following ones keep an error.
+func (m *Multicall) Execute(ctx context.Context) error {
+	calls, handlers := m.calls, m.handlers
+	m.calls, m.handlers = nil, nil
+
+	session := &multicallSession{instance: m.instance, callOpts: m.callOpts}
+	for start := 0; start < len(calls); start += m.chunkSize {
+		end := min(start+m.chunkSize, len(calls))
+		results, err := CallCtx(ctx, session, m.multicall.PackAggregate3(calls[start:end]), m.multicall.UnpackAggregate3)
+		if err != nil {
+			return fmt.Errorf("multicall of calls %d to %d failed: %w", start, end-1, err)
+		}
+		if len(results) != end-start {
+			return fmt.Errorf("multicall returned %d results for %d calls", len(results), end-start)
+		}
+		for idx, result := range results {
+			handlers[start+idx](result.Success, result.ReturnData)
+		}
+	}
+	return nil
+}
+
+// multicallSession is the session used to call the Multicall3 contract.
+type multicallSession struct {
+	instance *bind.BoundContract
+	callOpts *bind.CallOpts
+}
+
+func (s *multicallSession) CallOpts() *bind.CallOpts {
+	return s.callOpts
+}
+
+func (s *multicallSession) Instance() *bind.BoundContract {
+	return s.instance
+}
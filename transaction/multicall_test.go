@@ -0,0 +1,116 @@
+package transaction_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Thektonic/eth-interfaces/hex"
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Multicall verifies that queued calls are aggregated in chunks and that failures are reported per call.
+func Test_Multicall(t *testing.T) {
+	backend, auth, contractAddress, _, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	multicallAddress, err := testingtools.DeployMulticall3(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ierc20 := inferences.NewIerc20()
+	callOpts := &bind.CallOpts{From: auth.From}
+
+	testCases := []struct {
+		Name      string
+		ChunkSize int
+	}{
+		{
+			Name:      "OK - Single chunk",
+			ChunkSize: 0,
+		},
+		{
+			Name:      "OK - One call per chunk",
+			ChunkSize: 1,
+		},
+		{
+			Name:      "OK - Uneven chunks",
+			ChunkSize: 2,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			multicall := transaction.NewMulticall(backend.Client(), *multicallAddress, callOpts, tt.ChunkSize)
+
+			name := transaction.AddCall(multicall, *contractAddress, ierc20.PackName(), ierc20.UnpackName, false)
+			balance := transaction.AddCall(multicall,
+				*contractAddress,
+				ierc20.PackBalanceOf(auth.From),
+				ierc20.UnpackBalanceOf,
+				false,
+			)
+			// The multicall contract holds no token, the transfer reverts.
+			failed := transaction.AddCall(multicall,
+				*contractAddress,
+				ierc20.PackTransfer(auth.From, big.NewInt(1)),
+				ierc20.UnpackTransfer,
+				true,
+			)
+			assert.Equal(t, 3, multicall.Len())
+
+			_, err := name.Get()
+			assert.ErrorIs(t, err, transaction.ErrPendingResult)
+
+			err = multicall.Execute(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, 0, multicall.Len())
+
+			value, err := name.Get()
+			assert.Nil(t, err)
+			assert.NotEmpty(t, value)
+
+			amount, err := balance.Get()
+			assert.Nil(t, err)
+			assert.Equal(t, 0, amount.Cmp(new(big.Int).Mul(big.NewInt(100_000_000), big.NewInt(1e18))))
+
+			_, err = failed.Get()
+			var callErr *transaction.CallFailedError
+			assert.ErrorAs(t, err, &callErr)
+			data, ok := ethclient.RevertErrorData(err)
+			assert.True(t, ok)
+			unpacked, err := ierc20.UnpackError(data)
+			assert.Nil(t, err)
+			assert.IsType(t, &inferences.Ierc20ERC20InsufficientBalance{}, unpacked)
+		})
+	}
+
+	// A failing call not allowed to fail reverts the whole chunk.
+	multicall := transaction.NewMulticall(backend.Client(), *multicallAddress, callOpts, 0)
+	result := transaction.AddCall(multicall,
+		*contractAddress,
+		ierc20.PackTransfer(common.HexToAddress("0x1234"), hex.MaxUint256),
+		ierc20.UnpackTransfer,
+		false,
+	)
+	assert.Error(t, multicall.Execute(context.Background()))
+	_, err = result.Get()
+	assert.ErrorIs(t, err, transaction.ErrPendingResult)
+}
@@ -0,0 +1,306 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	bind2 "github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// DefaultBatchWindow is the default time calls are collected for before a batch is sent.
+	DefaultBatchWindow = 5 * time.Millisecond
+	// DefaultBatchSize is the default maximum number of calls sent in a single batch.
+	DefaultBatchSize = 100
+	// DefaultConcurrency is the number of calls Concurrently runs at once, enough to fill a default batch.
+	DefaultConcurrency = DefaultBatchSize
+)
+
+// ErrNoRPCClient is returned when batching is requested without a batch caller on a backend
+// not backed by an rpc.Client.
+var ErrNoRPCClient = errors.New("backend is not backed by an rpc client")
+
+// BatchCaller sends JSON-RPC batch requests, as rpc.Client does.
+type BatchCaller interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// rpcClientProvider is implemented by ethclient.Client and the clients embedding it.
+type rpcClientProvider interface {
+	Client() *rpc.Client
+}
+
+// batchedCall is an eth_call waiting for its batch to be sent.
+type batchedCall struct {
+	ctx  context.Context
+	elem rpc.BatchElem
+	done chan struct{}
+}
+
+// BatchingBackend is a contract backend coalescing the eth_call requests issued within a time window
+// into a single JSON-RPC batch. The other requests are forwarded to the wrapped backend.
+type BatchingBackend struct {
+	bind.ContractBackend
+	client  BatchCaller
+	window  time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	pending []*batchedCall
+	timer   *time.Timer
+	calls   uint64
+	batches uint64
+}
+
+// NewBatchingBackend wraps backend, sending the batched calls through client. When client is nil,
+// backend must be backed by an rpc.Client such as ethclient.Client.
+// A window or maxSize lower than 1 defaults to DefaultBatchWindow and DefaultBatchSize.
+func NewBatchingBackend(
+	backend bind.ContractBackend,
+	client BatchCaller,
+	window time.Duration,
+	maxSize int,
+) (*BatchingBackend, error) {
+	if client == nil {
+		provider, ok := backend.(rpcClientProvider)
+		if !ok || provider.Client() == nil {
+			return nil, ErrNoRPCClient
+		}
+		client = provider.Client()
+	}
+	if window <= 0 {
+		window = DefaultBatchWindow
+	}
+	if maxSize < 1 {
+		maxSize = DefaultBatchSize
+	}
+	return &BatchingBackend{
+		ContractBackend: backend,
+		client:          client,
+		window:          window,
+		maxSize:         maxSize,
+	}, nil
+}
+
+// CallContract queues an eth_call against the given block, the latest one when nil.
+func (b *BatchingBackend) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	return b.call(ctx, toCallArg(msg), toBlockNumArg(blockNumber))
+}
+
+// PendingCallContract queues an eth_call against the pending state.
+func (b *BatchingBackend) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return b.call(ctx, toCallArg(msg), "pending")
+}
+
+// CallContractAtHash queues an eth_call against the block with the given hash.
+func (b *BatchingBackend) CallContractAtHash(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockHash common.Hash,
+) ([]byte, error) {
+	return b.call(ctx, toCallArg(msg), rpc.BlockNumberOrHashWithHash(blockHash, false))
+}
+
+// CodeAtHash returns the code of the account at the block with the given hash.
+func (b *BatchingBackend) CodeAtHash(
+	ctx context.Context,
+	account common.Address,
+	blockHash common.Hash,
+) ([]byte, error) {
+	caller, ok := b.ContractBackend.(bind2.BlockHashContractCaller)
+	if !ok {
+		return nil, bind2.ErrNoBlockHashState
+	}
+	return caller.CodeAtHash(ctx, account, blockHash)
+}
+
+// Stats returns the number of calls and batches sent so far.
+func (b *BatchingBackend) Stats() (calls, batches uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.calls, b.batches
+}
+
+// call queues an eth_call and waits for the batch it belongs to.
+func (b *BatchingBackend) call(ctx context.Context, args ...interface{}) ([]byte, error) {
+	var result hexutil.Bytes
+	call := &batchedCall{
+		ctx:  ctx,
+		elem: rpc.BatchElem{Method: "eth_call", Args: args, Result: &result},
+		done: make(chan struct{}),
+	}
+	b.enqueue(call)
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if call.elem.Error != nil {
+		return nil, call.elem.Error
+	}
+	return result, nil
+}
+
+// enqueue adds the call to the pending batch, sending it when full or once the window elapsed.
+func (b *BatchingBackend) enqueue(call *batchedCall) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, call)
+	b.calls++
+	switch {
+	case len(b.pending) >= b.maxSize:
+		if b.timer != nil {
+			b.timer.Stop()
+			b.timer = nil
+		}
+		go b.send(b.take())
+	case len(b.pending) == 1:
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+}
+
+// flush sends the pending batch.
+func (b *BatchingBackend) flush() {
+	b.mu.Lock()
+	b.timer = nil
+	calls := b.take()
+	b.mu.Unlock()
+	b.send(calls)
+}
+
+// take returns the pending calls and counts them as a batch; the lock must be held.
+func (b *BatchingBackend) take() []*batchedCall {
+	calls := b.pending
+	b.pending = nil
+	if len(calls) > 0 {
+		b.batches++
+	}
+	return calls
+}
+
+// send performs the batch request and releases its callers. The request is canceled once every caller
+// gave up waiting for it.
+func (b *BatchingBackend) send(calls []*batchedCall) {
+	if len(calls) == 0 {
+		return
+	}
+	elems := make([]rpc.BatchElem, len(calls))
+	for idx, call := range calls {
+		elems[idx] = call.elem
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for _, call := range calls {
+			select {
+			case <-call.ctx.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+
+	err := b.client.BatchCallContext(ctx, elems)
+	for idx, call := range calls {
+		call.elem.Error = elems[idx].Error
+		if err != nil {
+			call.elem.Error = err
+		}
+		close(call.done)
+	}
+}
+
+// toCallArg encodes the call message as the eth_call transaction argument.
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["input"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.GasFeeCap != nil {
+		arg["maxFeePerGas"] = (*hexutil.Big)(msg.GasFeeCap)
+	}
+	if msg.GasTipCap != nil {
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
+	}
+	if msg.AccessList != nil {
+		arg["accessList"] = msg.AccessList
+	}
+	return arg
+}
+
+// toBlockNumArg encodes the block number as the eth_call block argument.
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	if number.Sign() >= 0 {
+		return hexutil.EncodeBig(number)
+	}
+	return rpc.BlockNumber(number.Int64()).String()
+}
+
+// Concurrently runs fn for every index from 0 to count-1 in parallel, DefaultConcurrency at a time, so
+// that the calls it issues through a BatchingBackend share batches, and returns the error of the lowest
+// failing index.
+func Concurrently(count int, fn func(index int) error) error {
+	return ConcurrentlyLimit(count, DefaultConcurrency, fn)
+}
+
+// ConcurrentlyLimit runs fn for every index like Concurrently, at most limit at a time.
+// A limit lower than 1 defaults to DefaultConcurrency.
+func ConcurrentlyLimit(count, limit int, fn func(index int) error) error {
+	if limit < 1 {
+		limit = DefaultConcurrency
+	}
+	errs := make([]error, count)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(count, limit) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				errs[idx] = fn(idx)
+			}
+		}()
+	}
+	for idx := range count {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package transaction_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Thektonic/eth-interfaces/inferences"
+	"github.com/Thektonic/eth-interfaces/testingtools"
+	"github.com/Thektonic/eth-interfaces/transaction"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	bind2 "github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_BatchingBackend verifies that concurrent calls are coalesced into batches and that reverts
+// keep their data.
+func Test_BatchingBackend(t *testing.T) {
+	backend, auth, contractAddress, _, err := testingtools.SetupBlockchain(t,
+		inferences.Ierc20MetaData.ABI,
+		inferences.Ierc20MetaData.Bin,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := backend.Close(); err != nil {
+			t.Logf("failed to close backend: %v", err)
+		}
+	}()
+
+	_, err = transaction.NewBatchingBackend(backend.Client(), nil, 0, 0)
+	assert.ErrorIs(t, err, transaction.ErrNoRPCClient)
+
+	proxy, err := testingtools.NewCallProxy(backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proxy.Close()
+
+	ierc20 := inferences.NewIerc20()
+	callOpts := &bind.CallOpts{From: auth.From}
+
+	testCases := []struct {
+		Name    string
+		Window  time.Duration
+		MaxSize int
+		Calls   int
+	}{
+		{
+			Name:    "OK - Single batch",
+			Window:  50 * time.Millisecond,
+			MaxSize: 0,
+			Calls:   10,
+		},
+		{
+			Name:    "OK - Batches split by size",
+			Window:  50 * time.Millisecond,
+			MaxSize: 3,
+			Calls:   10,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			batching, err := transaction.NewBatchingBackend(backend.Client(), proxy, tt.Window, tt.MaxSize)
+			assert.Nil(t, err)
+			instance := ierc20.Instance(batching, *contractAddress)
+
+			names := make([]string, tt.Calls)
+			err = transaction.Concurrently(tt.Calls, func(idx int) error {
+				name, err := bind2.Call(instance, callOpts, ierc20.PackName(), ierc20.UnpackName)
+				names[idx] = name
+				return err
+			})
+			assert.Nil(t, err)
+			for _, name := range names {
+				assert.NotEmpty(t, name)
+			}
+
+			calls, batches := batching.Stats()
+			assert.Equal(t, uint64(tt.Calls), calls)
+			assert.Less(t, batches, calls)
+		})
+	}
+
+	// A reverted call keeps its revert data, the sender holds no token.
+	batching, err := transaction.NewBatchingBackend(backend.Client(), proxy, 0, 0)
+	assert.Nil(t, err)
+	_, err = batching.CallContract(context.Background(), ethereum.CallMsg{
+		From: common.HexToAddress("0x5678"),
+		To:   contractAddress,
+		Data: ierc20.PackTransfer(common.HexToAddress("0x1234"), big.NewInt(1)),
+	}, nil)
+	assert.Error(t, err)
+	data, ok := ethclient.RevertErrorData(err)
+	assert.True(t, ok)
+	unpacked, err := ierc20.UnpackError(data)
+	assert.Nil(t, err)
+	assert.IsType(t, &inferences.Ierc20ERC20InsufficientBalance{}, unpacked)
+}
+
+// hangingCaller is a batch caller never answering, as a hung node, until the request is canceled.
+type hangingCaller struct {
+	canceled chan struct{}
+}
+
+func (c *hangingCaller) BatchCallContext(ctx context.Context, _ []rpc.BatchElem) error {
+	<-ctx.Done()
+	close(c.canceled)
+	return ctx.Err()
+}
+
+// Test_BatchingBackendCancel verifies that a batch request is canceled once its callers gave up.
+func Test_BatchingBackendCancel(t *testing.T) {
+	caller := &hangingCaller{canceled: make(chan struct{})}
+	batching, err := transaction.NewBatchingBackend(nil, caller, time.Millisecond, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = batching.CallContract(ctx, ethereum.CallMsg{}, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	select {
+	case <-caller.canceled:
+	case <-time.After(time.Second):
+		t.Error("batch request not canceled")
+	}
+}
+
+// Test_ConcurrentlyLimit verifies that every index runs, no more than the limit at a time.
+func Test_ConcurrentlyLimit(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Count int
+		Limit int
+	}{
+		{Name: "OK - Limited", Count: 50, Limit: 4},
+		{Name: "OK - Fewer indexes than the limit", Count: 3, Limit: 10},
+		{Name: "OK - Default limit", Count: 2 * transaction.DefaultConcurrency, Limit: 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			limit := tt.Limit
+			if limit < 1 {
+				limit = transaction.DefaultConcurrency
+			}
+			var running, peak atomic.Int32
+			ran := make([]bool, tt.Count)
+			err := transaction.ConcurrentlyLimit(tt.Count, tt.Limit, func(idx int) error {
+				current := running.Add(1)
+				defer running.Add(-1)
+				for {
+					highest := peak.Load()
+					if current <= highest || peak.CompareAndSwap(highest, current) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				ran[idx] = true
+				return nil
+			})
+			assert.Nil(t, err)
+			assert.LessOrEqual(t, int(peak.Load()), limit)
+			assert.NotContains(t, ran, false)
+		})
+	}
+
+	err := transaction.ConcurrentlyLimit(10, 2, func(idx int) error {
+		if idx >= 5 {
+			return fmt.Errorf("index %d failed", idx)
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "index 5 failed")
+}
@@ -1,6 +1,8 @@
 package transaction
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	bind2 "github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -27,8 +29,51 @@ func Transact[T any](
 	if err != nil {
 		return nil, err
 	}
+	return send(interaction, s, txOpts, calldata)
+}
+
+// TransactCtx sends a transaction like Transact, running the safe mode simulation, the transaction
+// options setup and the broadcast with the given context.
+func TransactCtx[T any](
+	ctx context.Context,
+	interaction Interaction,
+	s Session,
+	calldata []byte,
+	unpack func([]byte) (T, error),
+) (*types.Transaction, error) {
+	if interaction.Safe() {
+		_, err := CallCtx(ctx, s, calldata, unpack)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var txOpts *bind.TransactOpts
+	var err error
+	if ctxInteraction, ok := interaction.(ContextInteraction); ok {
+		txOpts, err = ctxInteraction.BaseTxSetupCtx(ctx)
+	} else {
+		txOpts, err = interaction.BaseTxSetup()
+	}
+	if err != nil {
+		return nil, err
+	}
+	txOpts.Context = ctx
+	return send(interaction, s, txOpts, calldata)
+}
+
+// send broadcasts the transaction, releasing its nonce when it could not be sent.
+func send(
+	interaction Interaction,
+	s Session,
+	txOpts *bind.TransactOpts,
+	calldata []byte,
+) (*types.Transaction, error) {
 	tx, err := bind2.Transact(s.Instance(), txOpts, calldata)
 	if err != nil {
+		if tracker, ok := interaction.(NonceTracker); ok && txOpts.Nonce != nil {
+			tracker.ReleaseNonce(txOpts.Nonce.Uint64(), err)
+		}
 		return nil, err
 	}
 	return tx, err
@@ -0,0 +1,197 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// DefaultWatchPollInterval is the interval logs are polled at when the client does not support subscriptions.
+const DefaultWatchPollInterval = 2 * time.Second
+
+// Subscription delivers the events of a watch until its context is done, it is unsubscribed or it fails.
+type Subscription[T any] struct {
+	events chan T
+	err    chan error
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// Events returns the channel the events are delivered on, closed when the subscription ends.
+func (s *Subscription[T]) Events() <-chan T {
+	return s.events
+}
+
+// Err returns the channel receiving the error that ended the subscription, if any.
+func (s *Subscription[T]) Err() <-chan error {
+	return s.err
+}
+
+// Unsubscribe ends the subscription and waits for its delivery to stop.
+func (s *Subscription[T]) Unsubscribe() {
+	s.once.Do(s.cancel)
+	<-s.done
+}
+
+// deliver sends the events, returning false once the subscription ended.
+func (s *Subscription[T]) deliver(ctx context.Context, events []T) bool {
+	for _, event := range events {
+		select {
+		case s.events <- event:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// WatchLogs delivers the events decoded from the logs matching query, emitted from now on. The logs
+// are streamed through SubscribeFilterLogs when the client supports it, and polled every pollInterval,
+// DefaultWatchPollInterval when 0, otherwise. decode may return several or no events for a log.
+// Logs removed by a reorganisation are only delivered by subscriptions, with their Removed flag set.
+func WatchLogs[T any](
+	ctx context.Context,
+	client LogReader,
+	query ethereum.FilterQuery,
+	pollInterval time.Duration,
+	decode func(log types.Log) ([]T, error),
+) (*Subscription[T], error) {
+	query.FromBlock, query.ToBlock = nil, nil
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Subscription[T]{
+		events: make(chan T),
+		err:    make(chan error, 1),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	logs := make(chan types.Log)
+	sub, err := client.SubscribeFilterLogs(ctx, query, logs)
+	switch {
+	case err == nil:
+		go s.stream(ctx, sub, logs, decode)
+	case subscriptionsUnsupported(err):
+		head, err := client.BlockNumber(ctx)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		if pollInterval <= 0 {
+			pollInterval = DefaultWatchPollInterval
+		}
+		go s.poll(ctx, client, query, head+1, pollInterval, decode)
+	default:
+		cancel()
+		return nil, err
+	}
+	return s, nil
+}
+
+// stream delivers the logs of a node subscription.
+func (s *Subscription[T]) stream(
+	ctx context.Context,
+	sub ethereum.Subscription,
+	logs <-chan types.Log,
+	decode func(log types.Log) ([]T, error),
+) {
+	defer s.close()
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case log := <-logs:
+			events, err := decode(log)
+			if err != nil {
+				s.err <- err
+				return
+			}
+			if !s.deliver(ctx, events) {
+				return
+			}
+		case err := <-sub.Err():
+			if err != nil {
+				s.err <- err
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll delivers the logs found in the blocks mined since the last poll, starting from block next.
+func (s *Subscription[T]) poll(
+	ctx context.Context,
+	client LogReader,
+	query ethereum.FilterQuery,
+	next uint64,
+	interval time.Duration,
+	decode func(log types.Log) ([]T, error),
+) {
+	defer s.close()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		head, err := client.BlockNumber(ctx)
+		if err != nil {
+			s.fail(ctx, err)
+			return
+		}
+		if head < next {
+			continue
+		}
+		logs, err := filterRange(ctx, client, query, next, head)
+		if err != nil {
+			s.fail(ctx, err)
+			return
+		}
+		for _, log := range logs {
+			events, err := decode(log)
+			if err != nil {
+				s.err <- err
+				return
+			}
+			if !s.deliver(ctx, events) {
+				return
+			}
+		}
+		next = head + 1
+	}
+}
+
+// fail reports err unless it was caused by the end of the subscription.
+func (s *Subscription[T]) fail(ctx context.Context, err error) {
+	if ctx.Err() == nil {
+		s.err <- err
+	}
+}
+
+// close ends the delivery.
+func (s *Subscription[T]) close() {
+	close(s.events)
+	close(s.done)
+	s.once.Do(s.cancel)
+}
+
+// subscriptionsUnsupported reports whether err is a client or node not supporting log subscriptions.
+func subscriptionsUnsupported(err error) bool {
+	if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "not supported") ||
+		strings.Contains(message, "does not exist") ||
+		strings.Contains(message, "method not found")
+}